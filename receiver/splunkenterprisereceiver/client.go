@@ -4,14 +4,34 @@
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/extension/auth"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
 
 // Indexer type "enum". Included in context sent from scraper functions
@@ -19,12 +39,24 @@ const (
 	typeIdx = "IDX"
 	typeSh  = "SH"
 	typeCm  = "CM"
+	typeACS = "ACS"
 )
 
 var (
+	errConnectTimeout         = errors.New("timed out establishing a connection")
+	errTLSHandshakeTimeout    = errors.New("timed out during the TLS handshake")
+	errResponseHeaderTimeout  = errors.New("timed out waiting for the response headers")
+	errReadTimeout            = errors.New("timed out reading the response body")
 	errCtxMissingEndpointType = errors.New("context was passed without the endpoint type included")
 	errEndpointTypeNotFound   = errors.New("requested client is not configured and could not be found in splunkEntClient")
 	errNoClientFound          = errors.New("no client corresponding to the endpoint type was found")
+
+	// The classes of failure returned by makeRequest, to be matched with errors.Is
+	errAuth       = errors.New("splunk rejected the credentials of the request")
+	errBadRequest = errors.New("splunk rejected the request")
+	errServer     = errors.New("splunk failed to serve the request")
+	errTimeout    = errors.New("request to splunk timed out")
+	errTransport  = errors.New("request to splunk failed")
 )
 
 // Type wrapper for accessing context value
@@ -33,6 +65,57 @@ type endpointType string
 // Wrapper around splunkClientMap to avoid awkward reference/dereference stuff that arises when using maps in golang
 type splunkEntClient struct {
 	clients splunkClientMap
+	// substituted into the searches dispatched by createRequest
+	searchVariables map[string]string
+	// the adhoc_search_level searches are dispatched with, left to splunk when empty
+	searchMode string
+	// the number of result rows read per request, all of them at once when 0
+	resultsChunkSize int
+	retries          Retries
+	// sent as a bearer token with every request when set, in place of an auth extension
+	authToken configopaque.String
+	// the session keys requests are authenticated with when logging in is configured
+	session *sessionAuth
+	// shared by the retries of every request made during a scrape
+	retryBudget *retryBudget
+}
+
+// A token bucket refilled at the start of every scrape, bounding the retries of all requests made during
+// the scrape. A nil budget allows any number of retries.
+type retryBudget struct {
+	mu     sync.Mutex
+	size   int
+	tokens int
+}
+
+func newRetryBudget(size int) *retryBudget {
+	if size <= 0 {
+		return nil
+	}
+	return &retryBudget{size: size, tokens: size}
+}
+
+// Takes a token from the budget, reporting false when none is left.
+func (b *retryBudget) take() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens == 0 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *retryBudget) refill() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.tokens = b.size
+	b.mu.Unlock()
 }
 
 // The splunkEntClient is made up of a number of splunkClients defined for each configured endpoint
@@ -46,7 +129,39 @@ type splunkClient struct {
 	endpoint *url.URL
 }
 
-func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySettings) (*splunkEntClient, error) {
+// The key of the client of each of the additional indexers in splunkClientMap, its position in the config.
+// Their requests carry it in their context along with the indexer endpoint type.
+type indexerKey int
+
+// Returns the key in splunkClientMap of the client the requests made with ctx are sent with
+func clientKey(ctx context.Context) any {
+	if i, ok := ctx.Value(endpointType("indexer")).(indexerKey); ok {
+		return i
+	}
+	return ctx.Value(endpointType("type"))
+}
+
+// Returns a context for the requests to each indexer of the tier, the indexer endpoint first followed by the
+// additional indexers in the order they are configured. There are none when the indexer is not configured.
+func (c *splunkEntClient) indexerContexts(ctx context.Context) []context.Context {
+	if !c.isConfigured(typeIdx) {
+		return nil
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
+	ctxs := []context.Context{ctx}
+	for i := indexerKey(0); ; i++ {
+		if _, ok := c.clients[i]; !ok {
+			return ctxs
+		}
+		ctxs = append(ctxs, context.WithValue(ctx, endpointType("indexer"), i))
+	}
+}
+
+// Wraps the transport built for each endpoint, so that tests and middleware such as tracing or custom
+// authentication can observe, alter or replace the requests sent to Splunk
+type transportWrapper func(http.RoundTripper) http.RoundTripper
+
+func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySettings, wrappers ...transportWrapper) (*splunkEntClient, error) {
 	var err error
 	var e *url.URL
 	var c *http.Client
@@ -56,7 +171,7 @@ func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySett
 	// we already checked that url.Parse does not fail in cfg.Validate()
 	if cfg.IdxEndpoint.Endpoint != "" {
 		e, _ = url.Parse(cfg.IdxEndpoint.Endpoint)
-		c, err = cfg.IdxEndpoint.ToClient(h, s)
+		c, err = cfg.newHTTPClient(cfg.IdxEndpoint, h, s, wrappers)
 		if err != nil {
 			return nil, err
 		}
@@ -65,9 +180,20 @@ func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySett
 			endpoint: e,
 		}
 	}
+	for i, hcs := range cfg.AdditionalIndexers {
+		e, _ = url.Parse(hcs.Endpoint)
+		c, err = cfg.newHTTPClient(hcs, h, s, wrappers)
+		if err != nil {
+			return nil, err
+		}
+		clientMap[indexerKey(i)] = splunkClient{
+			client:   c,
+			endpoint: e,
+		}
+	}
 	if cfg.SHEndpoint.Endpoint != "" {
 		e, _ = url.Parse(cfg.SHEndpoint.Endpoint)
-		c, err = cfg.SHEndpoint.ToClient(h, s)
+		c, err = cfg.newHTTPClient(cfg.SHEndpoint, h, s, wrappers)
 		if err != nil {
 			return nil, err
 		}
@@ -78,7 +204,7 @@ func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySett
 	}
 	if cfg.CMEndpoint.Endpoint != "" {
 		e, _ = url.Parse(cfg.CMEndpoint.Endpoint)
-		c, err = cfg.CMEndpoint.ToClient(h, s)
+		c, err = cfg.newHTTPClient(cfg.CMEndpoint, h, s, wrappers)
 		if err != nil {
 			return nil, err
 		}
@@ -87,8 +213,169 @@ func newSplunkEntClient(cfg *Config, h component.Host, s component.TelemetrySett
 			endpoint: e,
 		}
 	}
+	if cfg.ACSEndpoint.Endpoint != "" {
+		e, _ = url.Parse(cfg.ACSEndpoint.Endpoint)
+		c, err = cfg.newHTTPClient(cfg.ACSEndpoint, h, s, wrappers)
+		if err != nil {
+			return nil, err
+		}
+		clientMap[typeACS] = splunkClient{
+			client:   c,
+			endpoint: e,
+		}
+	}
+
+	return &splunkEntClient{
+		clients:          clientMap,
+		searchVariables:  cfg.SearchVariables,
+		searchMode:       cfg.SearchMode,
+		resultsChunkSize: cfg.ResultsChunkSize,
+		retries:          cfg.Retries,
+		retryBudget:      newRetryBudget(cfg.Retries.Budget),
+		authToken:        cfg.AuthToken,
+		session:          newSessionAuth(cfg.SessionAuth),
+	}, nil
+}
+
+// Builds the client for a single endpoint, applying the receiver wide settings to its config and the
+// wrappers to its transport, the first wrapper being the outermost
+func (cfg *Config) newHTTPClient(hcs confighttp.ClientConfig, h component.Host, s component.TelemetrySettings, wrappers []transportWrapper) (*http.Client, error) {
+	var configure []func(*http.Transport)
+	if cfg.CAPath != "" {
+		pool, err := loadCAs(cfg.CAPath, hcs.TLSSetting.CAFile, string(hcs.TLSSetting.CAPem))
+		if err != nil {
+			return nil, err
+		}
+		hcs.TLSSetting.CAFile = ""
+		hcs.TLSSetting.CAPem = ""
+		configure = append(configure, func(t *http.Transport) {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			t.TLSClientConfig.RootCAs = pool
+		})
+	}
+	if cfg.DisableHTTP2 {
+		configure = append(configure, func(t *http.Transport) {
+			// a non nil TLSNextProto keeps the transport from upgrading TLS connections to HTTP/2
+			t.ForceAttemptHTTP2 = false
+			t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		})
+	}
+	if len(configure) > 0 {
+		var err error
+		if hcs, h, err = configureTransport(hcs, h, configure); err != nil {
+			return nil, err
+		}
+	}
+	c, err := cfg.RequestTimeouts.apply(hcs).ToClient(h, s)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(wrappers) - 1; i >= 0; i-- {
+		c.Transport = wrappers[i](c.Transport)
+	}
+	return c, nil
+}
 
-	return &splunkEntClient{clients: clientMap}, nil
+// The ID the transport hook is registered under in place of the endpoint's authenticator
+var transportHookID = component.MustNewIDWithName(metadata.Type.String(), "transport")
+
+// A host exposing nothing but the transport hook as its extensions
+type transportHookHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h transportHookHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// confighttp builds the transport of an endpoint itself and only hands it to the endpoint's authenticator,
+// so an authenticator standing in for the configured one applies the given settings to the transport
+// before handing it on.
+func configureTransport(hcs confighttp.ClientConfig, h component.Host, configure []func(*http.Transport)) (confighttp.ClientConfig, component.Host, error) {
+	var next auth.Client
+	if hcs.Auth != nil {
+		ext := h.GetExtensions()
+		if ext == nil {
+			return hcs, h, errors.New("extensions configuration not found")
+		}
+		var err error
+		if next, err = hcs.Auth.GetClientAuthenticator(ext); err != nil {
+			return hcs, h, err
+		}
+	}
+
+	hook := auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+		if t, ok := base.(*http.Transport); ok {
+			for _, f := range configure {
+				f(t)
+			}
+		}
+		if next == nil {
+			return base, nil
+		}
+		return next.RoundTripper(base)
+	}))
+	hcs.Auth = &configauth.Authentication{AuthenticatorID: transportHookID}
+	return hcs, transportHookHost{
+		Host:       h,
+		extensions: map[component.ID]component.Component{transportHookID: hook},
+	}, nil
+}
+
+// Builds a pool of the system certificates, the CA already configured on the endpoint and the certificates
+// found at caPath, which may be a file or a directory of files.
+func loadCAs(caPath, caFile, caPem string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the system certificate pool: %w", err)
+	}
+	appendFile := func(path string) error {
+		b, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return fmt.Errorf("failed to load CA %s: %w", path, err)
+		}
+		if !pool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("failed to load CA %s: no certificates found", path)
+		}
+		return nil
+	}
+
+	if caFile != "" {
+		if err = appendFile(caFile); err != nil {
+			return nil, err
+		}
+	}
+	if caPem != "" && !pool.AppendCertsFromPEM([]byte(caPem)) {
+		return nil, errors.New("failed to load CA from ca_pem: no certificates found")
+	}
+
+	info, err := os.Stat(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA %s: %w", caPath, err)
+	}
+	if !info.IsDir() {
+		if err = appendFile(caPath); err != nil {
+			return nil, err
+		}
+		return pool, nil
+	}
+
+	entries, err := os.ReadDir(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA %s: %w", caPath, err)
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if err = appendFile(filepath.Join(caPath, e.Name())); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
 }
 
 // For running ad hoc searches only
@@ -102,10 +389,10 @@ func (c *splunkEntClient) createRequest(ctx context.Context, sr *searchResponse)
 	// Running searches via Splunk's REST API is a two step process: First you submit the job to run
 	// this returns a jobid which is then used in the second part to retrieve the search results
 	if sr.Jobid == nil {
-		var u string
+		var u, search string
 		path := "/services/search/jobs/"
 
-		if e, ok := c.clients[eptType]; ok {
+		if e, ok := c.clients[clientKey(ctx)]; ok {
 			u, err = url.JoinPath(e.endpoint.String(), path)
 			if err != nil {
 				return nil, err
@@ -114,24 +401,62 @@ func (c *splunkEntClient) createRequest(ctx context.Context, sr *searchResponse)
 			return nil, errNoClientFound
 		}
 
+		search, err = renderSearch(sr.search, c.searchVariables)
+		if err != nil {
+			return nil, err
+		}
+		if c.searchMode != "" {
+			search += "&adhoc_search_level=" + c.searchMode
+		}
+
 		// reader for the response data
-		data := strings.NewReader(sr.search)
+		data := strings.NewReader(search)
 
 		// return the build request, ready to be run by makeRequest
 		req, err = http.NewRequestWithContext(ctx, http.MethodPost, u, data)
 		if err != nil {
 			return nil, err
 		}
+		c.authorize(req)
 
 		return req, nil
 	}
 	path := fmt.Sprintf("/services/search/jobs/%s/results", *sr.Jobid)
-	url, _ := url.JoinPath(c.clients[eptType].endpoint.String(), path)
+	url, _ := url.JoinPath(c.clients[clientKey(ctx)].endpoint.String(), path)
+	if c.resultsChunkSize > 0 {
+		url += fmt.Sprintf("?count=%d&offset=%d", c.resultsChunkSize, sr.offset)
+	}
 
 	req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	c.authorize(req)
+
+	return req, nil
+}
+
+// forms an *http.Request deleting a search job, which cancels the job if it is still running and removes
+// its artifacts from the dispatch directory of the search head
+func (c *splunkEntClient) createCancelRequest(ctx context.Context, jobid string) (*http.Request, error) {
+	eptType := ctx.Value(endpointType("type"))
+	if eptType == nil {
+		return nil, errCtxMissingEndpointType
+	}
+	e, ok := c.clients[clientKey(ctx)]
+	if !ok {
+		return nil, errNoClientFound
+	}
+
+	u, err := url.JoinPath(e.endpoint.String(), "/services/search/jobs/", url.PathEscape(jobid))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.authorize(req)
 
 	return req, nil
 }
@@ -146,7 +471,7 @@ func (c *splunkEntClient) createAPIRequest(ctx context.Context, apiEndpoint stri
 		return nil, errCtxMissingEndpointType
 	}
 
-	if e, ok := c.clients[eptType]; ok {
+	if e, ok := c.clients[clientKey(ctx)]; ok {
 		u = e.endpoint.String() + apiEndpoint
 	} else {
 		return nil, errNoClientFound
@@ -156,25 +481,277 @@ func (c *splunkEntClient) createAPIRequest(ctx context.Context, apiEndpoint stri
 	if err != nil {
 		return nil, err
 	}
+	c.authorize(req)
 
 	return req, nil
 }
 
-// Perform a request.
+// Sets the auth token as the bearer token of req, or the session key of its endpoint. Without either, the
+// auth extension of the endpoint authenticates the request instead.
+func (c *splunkEntClient) authorize(req *http.Request) {
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+string(c.authToken))
+		return
+	}
+	if key := c.session.key(clientKey(req.Context())); key != "" {
+		req.Header.Set("Authorization", "Splunk "+key)
+	}
+}
+
+// The session keys obtained by logging in to each endpoint. Scrapes running concurrently share them, and
+// whichever request first finds a key expired logs in again for all of them.
+type sessionAuth struct {
+	username string
+	password configopaque.String
+
+	mu   sync.RWMutex
+	keys map[any]string
+}
+
+func newSessionAuth(cfg *SessionAuth) *sessionAuth {
+	if cfg == nil {
+		return nil
+	}
+	return &sessionAuth{
+		username: cfg.Username,
+		password: cfg.Password,
+		keys:     make(map[any]string),
+	}
+}
+
+// Returns the session key of an endpoint, or an empty string when there is none.
+func (sa *sessionAuth) key(ept any) string {
+	if sa == nil {
+		return ""
+	}
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	return sa.keys[ept]
+}
+
+// Logs in to every endpoint which supports it, keeping the session keys obtained. An endpoint failing to
+// log in is left without a key, and logs in again once its requests are rejected.
+func (c *splunkEntClient) login(ctx context.Context) (errs error) {
+	if c.session == nil {
+		return nil
+	}
+	// every indexer of the tier has a session key of its own
+	ctxs := c.indexerContexts(ctx)
+	for _, eptType := range []string{typeSh, typeCm} {
+		if c.isConfigured(eptType) {
+			ctxs = append(ctxs, context.WithValue(ctx, endpointType("type"), eptType))
+		}
+	}
+	for _, ctx := range ctxs {
+		if err := c.renewSessionKey(ctx, ""); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Logs in to the endpoint of ctx through services/auth/login and keeps the session key in the response.
+// When the key of the endpoint is no longer stale, another request already logged in again and the new
+// key is kept.
+func (c *splunkEntClient) renewSessionKey(ctx context.Context, stale string) error {
+	key := clientKey(ctx)
+	sc, ok := c.clients[key]
+	if !ok {
+		return errNoClientFound
+	}
+
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	if c.session.keys[key] != stale {
+		return nil
+	}
+
+	u, err := url.JoinPath(sc.endpoint.String(), "/services/auth/login")
+	if err != nil {
+		return err
+	}
+	form := url.Values{"username": {c.session.username}, "password": {string(c.session.password)}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := sc.client.Do(req)
+	if err != nil {
+		return transportError(err)
+	}
+	defer res.Body.Close()
+	if err = statusError(req, res); err != nil {
+		return err
+	}
+
+	var login struct {
+		SessionKey string `xml:"sessionKey"`
+	}
+	if err = xml.NewDecoder(res.Body).Decode(&login); err != nil {
+		return err
+	}
+	if login.SessionKey == "" {
+		return fmt.Errorf("%w: %s %s returned no session key", errAuth, req.Method, req.URL.Path)
+	}
+	c.session.keys[key] = login.SessionKey
+	return nil
+}
+
+// Perform a request. Responses with a status outside of the 2xx range are returned as an error wrapping
+// errAuth, errBadRequest, errTimeout or errServer, and failures to get a response at all wrap errTimeout or
+// errTransport.
 func (c *splunkEntClient) makeRequest(req *http.Request) (*http.Response, error) {
 	// get endpoint type from the context
 	eptType := req.Context().Value(endpointType("type"))
 	if eptType == nil {
 		return nil, errCtxMissingEndpointType
 	}
-	if sc, ok := c.clients[eptType]; ok {
+	sc, ok := c.clients[clientKey(req.Context())]
+	if !ok {
+		return nil, errEndpointTypeNotFound
+	}
+
+	renewed := false
+	for attempt := 0; ; attempt++ {
 		res, err := sc.client.Do(req)
+		if err != nil {
+			err = transportError(err)
+		} else if err = statusError(req, res); err != nil {
+			res.Body.Close()
+			// the session key expired, the request is sent again once logged in without counting as a retry
+			if res.StatusCode == http.StatusUnauthorized && c.session != nil && eptType != typeACS && !renewed {
+				renewed = true
+				if req, err = c.resendWithNewSession(req); err != nil {
+					return nil, err
+				}
+				attempt--
+				continue
+			}
+		} else {
+			return res, nil
+		}
+
+		if !retryable(err) || attempt >= c.retries.MaxRetries || !c.retryBudget.take() {
+			return nil, err
+		}
+		if req, err = c.retryRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Only failures which may go away on their own are retried, as opposed to rejected requests.
+func retryable(err error) bool {
+	return errors.Is(err, errTimeout) || errors.Is(err, errServer) || errors.Is(err, errTransport)
+}
+
+// Waits out the retry backoff and returns a copy of req whose body can be sent again.
+func (c *splunkEntClient) retryRequest(req *http.Request) (*http.Request, error) {
+	if c.retries.Backoff > 0 {
+		t := time.NewTimer(c.retries.Backoff)
+		select {
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, transportError(req.Context().Err())
+		case <-t.C:
+		}
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+// Logs in to the endpoint of req again and returns a copy of req authenticated with the new session key.
+func (c *splunkEntClient) resendWithNewSession(req *http.Request) (*http.Request, error) {
+	stale := strings.TrimPrefix(req.Header.Get("Authorization"), "Splunk ")
+	if err := c.renewSessionKey(req.Context(), stale); err != nil {
+		return nil, err
+	}
+
+	resend := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
 		if err != nil {
 			return nil, err
 		}
-		return res, nil
+		resend.Body = body
+	}
+	c.authorize(resend)
+	return resend, nil
+}
+
+// Refills the retry budget for a new scrape
+func (c *splunkEntClient) resetRetryBudget() {
+	c.retryBudget.refill()
+}
+
+// Classifies a failure to get any response from Splunk
+func transportError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errConnectTimeout) || errors.Is(err, errTLSHandshakeTimeout) ||
+		errors.Is(err, errResponseHeaderTimeout) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return fmt.Errorf("%w: %w", errTimeout, err)
+	}
+	return fmt.Errorf("%w: %w", errTransport, err)
+}
+
+// Classifies a response from Splunk by its status code, returning nil for successful responses
+func statusError(req *http.Request, res *http.Response) error {
+	var class error
+	switch code := res.StatusCode; {
+	case code >= 200 && code <= 299:
+		return nil
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		class = errAuth
+	case code == http.StatusRequestTimeout || code == http.StatusGatewayTimeout:
+		class = errTimeout
+	case code >= 500:
+		class = errServer
+	default:
+		class = errBadRequest
+	}
+	if msg := responseMessages(res); msg != "" {
+		return fmt.Errorf("%w: %s %s returned status %d: %s", class, req.Method, req.URL.Path, res.StatusCode, msg)
+	}
+	return fmt.Errorf("%w: %s %s returned status %d", class, req.Method, req.URL.Path, res.StatusCode)
+}
+
+// The size of a failed response body read for the messages explaining the failure
+const messagesMaxBody = 16 * 1024
+
+// Returns the messages Splunk explains a failed request with, from the messages of the response body in
+// either its XML or its JSON output mode, or an empty string when the body holds none.
+func responseMessages(res *http.Response) string {
+	body, err := io.ReadAll(io.LimitReader(res.Body, messagesMaxBody))
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+
+	var msgs struct {
+		Messages []struct {
+			Text string `xml:",chardata" json:"text"`
+		} `xml:"messages>msg" json:"messages"`
+	}
+	if json.Unmarshal(body, &msgs) != nil && xml.Unmarshal(body, &msgs) != nil {
+		return ""
+	}
+
+	texts := make([]string, 0, len(msgs.Messages))
+	for _, m := range msgs.Messages {
+		if t := strings.TrimSpace(m.Text); t != "" {
+			texts = append(texts, t)
+		}
 	}
-	return nil, errEndpointTypeNotFound
+	return strings.Join(texts, "; ")
 }
 
 // Check if the splunkEntClient contains a configured endpoint for the type of scraper
@@ -183,3 +760,285 @@ func (c *splunkEntClient) isConfigured(v string) bool {
 	_, ok := c.clients[v]
 	return ok
 }
+
+// Returns a copy of the endpoint config whose transport enforces the request phase timeouts
+func (rt RequestTimeouts) apply(hcs confighttp.ClientConfig) *confighttp.ClientConfig {
+	if rt == (RequestTimeouts{}) {
+		return &hcs
+	}
+
+	next := hcs.CustomRoundTripper
+	hcs.CustomRoundTripper = func(transport http.RoundTripper) (http.RoundTripper, error) {
+		if next != nil {
+			var err error
+			if transport, err = next(transport); err != nil {
+				return nil, err
+			}
+		}
+		return &timeoutRoundTripper{next: transport, timeouts: rt}, nil
+	}
+	return &hcs
+}
+
+// The transport built by confighttp does not expose its dialer, so rather than setting the timeouts on the
+// transport each phase of a request is traced and the request is cancelled when a phase runs too long.
+type timeoutRoundTripper struct {
+	next     http.RoundTripper
+	timeouts RequestTimeouts
+}
+
+func (t *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancelCause(req.Context())
+
+	var mu sync.Mutex
+	var timer *time.Timer
+	startPhase := func(d time.Duration, cause error) {
+		if d <= 0 {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(d, func() { cancel(cause) })
+	}
+	endPhase := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+
+	trace := &httptrace.ClientTrace{
+		ConnectStart:         func(string, string) { startPhase(t.timeouts.Connect, errConnectTimeout) },
+		ConnectDone:          func(string, string, error) { endPhase() },
+		TLSHandshakeStart:    func() { startPhase(t.timeouts.TLSHandshake, errTLSHandshakeTimeout) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { endPhase() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { startPhase(t.timeouts.ResponseHeader, errResponseHeaderTimeout) },
+		GotFirstResponseByte: endPhase,
+	}
+
+	res, err := t.next.RoundTrip(req.WithContext(httptrace.WithClientTrace(ctx, trace)))
+	if err != nil {
+		endPhase()
+		err = timeoutCause(ctx, err)
+		cancel(nil)
+		return nil, err
+	}
+
+	startPhase(t.timeouts.Read, errReadTimeout)
+	res.Body = &timeoutBody{
+		ReadCloser: res.Body,
+		ctx:        ctx,
+		done: func() {
+			endPhase()
+			cancel(nil)
+		},
+	}
+	return res, nil
+}
+
+// Response body which reports read timeouts and releases the request's timers once closed
+type timeoutBody struct {
+	io.ReadCloser
+	ctx  context.Context
+	done func()
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = timeoutCause(b.ctx, err)
+	}
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	defer b.done()
+	return b.ReadCloser.Close()
+}
+
+// Adds the phase that timed out to an error caused by the request being cancelled
+func timeoutCause(ctx context.Context, err error) error {
+	switch cause := context.Cause(ctx); cause {
+	case errConnectTimeout, errTLSHandshakeTimeout, errResponseHeaderTimeout, errReadTimeout:
+		return fmt.Errorf("%w: %w", cause, err)
+	}
+	return err
+}
+
+// The names the endpoints are configured under, by endpoint type
+var endpointNames = map[string]string{typeIdx: "indexer", typeSh: "search_head", typeCm: "cluster_master", typeACS: "acs"}
+
+// Traces the phases of the connections of the requests sent to Splunk, keeping the longest time each phase
+// took per endpoint type until the timings are taken. Phases a request skips, such as the DNS lookup and
+// TLS handshake of a request reusing a connection, are not timed.
+type connectionTracer struct {
+	mu      sync.Mutex
+	timings map[string]connectionTimings
+}
+
+type connectionTimings struct {
+	dns          time.Duration
+	tlsHandshake time.Duration
+	// from the request being written to the first byte of the response
+	firstByte time.Duration
+}
+
+func (c *connectionTracer) wrap(next http.RoundTripper) http.RoundTripper {
+	return &tracingRoundTripper{next: next, tracer: c}
+}
+
+// Returns the longest timings of each endpoint type since the last call
+func (c *connectionTracer) take() map[string]connectionTimings {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	timings := c.timings
+	c.timings = nil
+	return timings
+}
+
+func (c *connectionTracer) record(ept string, t connectionTimings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.timings == nil {
+		c.timings = make(map[string]connectionTimings)
+	}
+	longest := c.timings[ept]
+	longest.dns = max(longest.dns, t.dns)
+	longest.tlsHandshake = max(longest.tlsHandshake, t.tlsHandshake)
+	longest.firstByte = max(longest.firstByte, t.firstByte)
+	c.timings[ept] = longest
+}
+
+type tracingRoundTripper struct {
+	next   http.RoundTripper
+	tracer *connectionTracer
+}
+
+func (t *tracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the hooks of a trace may be called from the goroutine dialing the connection
+	var mu sync.Mutex
+	var timings connectionTimings
+	var dnsStart, tlsStart, wrote time.Time
+	begin := func(at *time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		*at = time.Now()
+	}
+	end := func(at *time.Time, d *time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !at.IsZero() {
+			*d = time.Since(*at)
+		}
+	}
+
+	trace := &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { begin(&dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { end(&dnsStart, &timings.dns) },
+		TLSHandshakeStart:    func() { begin(&tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { end(&tlsStart, &timings.tlsHandshake) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { begin(&wrote) },
+		GotFirstResponseByte: func() { end(&wrote, &timings.firstByte) },
+	}
+
+	res, err := t.next.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+
+	ept, _ := req.Context().Value(endpointType("type")).(string)
+	mu.Lock()
+	t.tracer.record(ept, timings)
+	mu.Unlock()
+	return res, err
+}
+
+// The size of the response bodies recorded for the diagnostics of a scrape, beyond which they are cut short
+const diagnosticsMaxBody = 64 * 1024
+
+// Matches the credentials which may appear in the URLs and bodies of requests to Splunk: query parameters,
+// JSON and XML fields named after passwords, tokens and session keys
+var credentialPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)((?:password|passwd|token|secret|session_?key|authorization)=)[^&\s"]*`),
+	regexp.MustCompile(`(?i)("[^"]*(?:password|passwd|token|secret|session_?key|authorization)[^"]*"\s*:\s*)"[^"]*"`),
+	regexp.MustCompile(`(?i)(<(password|passwd|token|secret|session_?key|authorization)>)[^<]*(</)`),
+}
+
+func redactCredentials(s string) string {
+	s = credentialPatterns[0].ReplaceAllString(s, "${1}REDACTED")
+	s = credentialPatterns[1].ReplaceAllString(s, `${1}"REDACTED"`)
+	return credentialPatterns[2].ReplaceAllString(s, "${1}REDACTED${3}")
+}
+
+// Records the requests sent to Splunk along with their responses and timings, which the scraper writes out
+// as the diagnostics of each scrape. Credentials are redacted as the exchanges are recorded.
+type diagnosticsRecorder struct {
+	mu        sync.Mutex
+	exchanges []diagnosticExchange
+}
+
+type diagnosticExchange struct {
+	Method   string `json:"method"`
+	URL      string `json:"url"`
+	Status   int    `json:"status,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+	Response string `json:"response,omitempty"`
+}
+
+func (d *diagnosticsRecorder) wrap(next http.RoundTripper) http.RoundTripper {
+	return &diagnosticsRoundTripper{next: next, recorder: d}
+}
+
+// Returns the exchanges recorded since the last call
+func (d *diagnosticsRecorder) take() []diagnosticExchange {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	exchanges := d.exchanges
+	d.exchanges = nil
+	return exchanges
+}
+
+type diagnosticsRoundTripper struct {
+	next     http.RoundTripper
+	recorder *diagnosticsRecorder
+}
+
+func (t *diagnosticsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	ex := diagnosticExchange{
+		Method: req.Method,
+		URL:    redactCredentials(req.URL.Redacted()),
+	}
+	defer func() {
+		ex.Duration = time.Since(start).String()
+		t.recorder.mu.Lock()
+		t.recorder.exchanges = append(t.recorder.exchanges, ex)
+		t.recorder.mu.Unlock()
+	}()
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		ex.Error = redactCredentials(err.Error())
+		return nil, err
+	}
+
+	// the body is read in full so that reading it is part of the timing of the exchange, and handed back
+	// as if unread
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		ex.Error = redactCredentials(err.Error())
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	ex.Status = res.StatusCode
+	if len(body) > diagnosticsMaxBody {
+		body = body[:diagnosticsMaxBody]
+	}
+	ex.Response = redactCredentials(string(body))
+	return res, nil
+}
@@ -5,10 +5,17 @@ package splunkenterprisereceiver // import "github.com/open-telemetry/openteleme
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,8 +24,11 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/extension/auth"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
 
 // mockHost allows us to create a test host with a no op extension that can be used to satisfy the SDK without having to parse from an
@@ -141,6 +151,38 @@ func TestClientCreateRequest(t *testing.T) {
 	}
 }
 
+// the search dispatched by createRequest has the configured search variables substituted into it
+func TestClientCreateRequestTemplated(t *testing.T) {
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: "https://localhost:8089",
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+		SearchVariables: map[string]string{"MountPoint": "/data/splunk var"},
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	req, err := client.createRequest(ctx, &searchResponse{search: searchDict[`SplunkIoAvgIops`]})
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `search data.mount_point="%2Fdata%2Fsplunk+var"`)
+	require.NotContains(t, string(body), "{{")
+
+	client.searchVariables = nil
+	_, err = client.createRequest(ctx, &searchResponse{search: searchDict[`SplunkIoAvgIops`]})
+	require.ErrorContains(t, err, "MountPoint")
+}
+
 // createAPIRequest creates a request for api calls i.e. to introspection endpoint
 func TestAPIRequestCreate(t *testing.T) {
 	cfg := &Config{
@@ -179,3 +221,604 @@ func TestAPIRequestCreate(t *testing.T) {
 	require.Equal(t, expected.Header, req.Header)
 	require.Equal(t, expected.Body, req.Body)
 }
+
+func TestCancelRequestCreate(t *testing.T) {
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: "https://localhost:8089",
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	req, err := client.createCancelRequest(ctx, "admin__admin__search__1712.5")
+	require.NoError(t, err)
+	require.Equal(t, http.MethodDelete, req.Method)
+	require.Equal(t, "https://localhost:8089/services/search/jobs/admin__admin__search__1712.5", req.URL.String())
+
+	_, err = client.createCancelRequest(context.Background(), "123")
+	require.ErrorIs(t, err, errCtxMissingEndpointType)
+}
+
+func TestClientReadTimeout(t *testing.T) {
+	// headers are sent straight away but the body never arrives
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"entry":[`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: ts.URL,
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+			Timeout:  10 * time.Second,
+		},
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 10 * time.Second,
+			InitialDelay:       1 * time.Second,
+			Timeout:            11 * time.Second,
+		},
+		RequestTimeouts: RequestTimeouts{
+			ResponseHeader: 5 * time.Second,
+			Read:           100 * time.Millisecond,
+		},
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	req, err := client.createAPIRequest(ctx, "/services/data/indexes-extended")
+	require.NoError(t, err)
+
+	start := time.Now()
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	_, err = io.ReadAll(res.Body)
+	require.ErrorIs(t, err, errReadTimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestClientCAPath(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the configured authenticator must still be handed the transport
+		if u, p, ok := r.BasicAuth(); !ok || u != "admin" || p != "changeme" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// the CA of the test server is only found in the directory pointed to by ca_path
+	caDir := t.TempDir()
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, os.WriteFile(filepath.Join(caDir, "splunk-ca.pem"), caPem, 0o600))
+
+	badDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(badDir, "README"), []byte("not a certificate"), 0o600))
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+				return &basicAuthRoundTripper{next: base}, nil
+			})),
+		},
+	}
+
+	tests := []struct {
+		desc      string
+		caPath    string
+		caFile    string
+		createErr bool
+		reqErr    bool
+	}{
+		{desc: "no ca_path", reqErr: true},
+		{desc: "ca_path directory", caPath: caDir},
+		{desc: "ca_path file", caPath: filepath.Join(caDir, "splunk-ca.pem")},
+		{desc: "missing ca_path", caPath: filepath.Join(badDir, "missing"), createErr: true},
+		{desc: "ca_path without certificates", caPath: badDir, createErr: true},
+		{desc: "ca_file without certificates", caPath: caDir, caFile: filepath.Join(badDir, "README"), createErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Endpoint: ts.URL,
+					Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+					Timeout:  10 * time.Second,
+				},
+				CAPath: tt.caPath,
+			}
+			cfg.IdxEndpoint.TLSSetting.CAFile = tt.caFile
+
+			client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+			if tt.createErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+			req, err := client.createAPIRequest(ctx, "/services/server/info")
+			require.NoError(t, err)
+
+			res, err := client.makeRequest(req)
+			if tt.reqErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			res.Body.Close()
+			require.Equal(t, http.StatusOK, res.StatusCode)
+		})
+	}
+}
+
+func TestMakeRequestErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		status   int
+		expected error
+	}{
+		{desc: "success", status: http.StatusOK},
+		{desc: "results pending", status: http.StatusNoContent},
+		{desc: "unauthorized", status: http.StatusUnauthorized, expected: errAuth},
+		{desc: "forbidden", status: http.StatusForbidden, expected: errAuth},
+		{desc: "bad request", status: http.StatusBadRequest, expected: errBadRequest},
+		{desc: "not found", status: http.StatusNotFound, expected: errBadRequest},
+		{desc: "gateway timeout", status: http.StatusGatewayTimeout, expected: errTimeout},
+		{desc: "internal error", status: http.StatusInternalServerError, expected: errServer},
+		{desc: "unavailable", status: http.StatusServiceUnavailable, expected: errServer},
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer ts.Close()
+
+			client, err := newSplunkEntClient(createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{}), host, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			req, err := client.createAPIRequest(ctx, "/services/server/info")
+			require.NoError(t, err)
+
+			res, err := client.makeRequest(req)
+			if test.expected == nil {
+				require.NoError(t, err)
+				res.Body.Close()
+				return
+			}
+			require.ErrorIs(t, err, test.expected)
+			require.Nil(t, res)
+		})
+	}
+
+	t.Run("transport failure", func(t *testing.T) {
+		// nothing listens on the endpoint once the server is closed
+		ts := httptest.NewServer(http.NotFoundHandler())
+		ts.Close()
+
+		client, err := newSplunkEntClient(createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{}), host, componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+
+		req, err := client.createAPIRequest(ctx, "/services/server/info")
+		require.NoError(t, err)
+
+		_, err = client.makeRequest(req)
+		require.ErrorIs(t, err, errTransport)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		cfg := createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{})
+		cfg.RequestTimeouts.ResponseHeader = 50 * time.Millisecond
+		client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+
+		req, err := client.createAPIRequest(ctx, "/services/server/info")
+		require.NoError(t, err)
+
+		_, err = client.makeRequest(req)
+		require.ErrorIs(t, err, errTimeout)
+		require.ErrorIs(t, err, errResponseHeaderTimeout)
+	})
+}
+
+func TestClientHTTP2(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	tests := []struct {
+		desc         string
+		disableHTTP2 bool
+		proto        string
+	}{
+		{desc: "negotiated by default", proto: "HTTP/2.0"},
+		{desc: "disabled", disableHTTP2: true, proto: "HTTP/1.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{})
+			cfg.IdxEndpoint.TLSSetting.CAPem = configopaque.String(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+			cfg.DisableHTTP2 = tt.disableHTTP2
+
+			client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+			for i := 0; i < 3; i++ {
+				req, err := client.createAPIRequest(ctx, "/services/server/info")
+				require.NoError(t, err)
+
+				res, err := client.makeRequest(req)
+				require.NoError(t, err)
+				res.Body.Close()
+				require.Equal(t, tt.proto, res.Proto)
+			}
+		})
+	}
+}
+
+func TestClientCreateRequestSearchMode(t *testing.T) {
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: "https://localhost:8089",
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+		SearchMode: searchModeFast,
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	req, err := client.createRequest(ctx, &searchResponse{search: "search=search index=_internal | stats count"})
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	form, err := url.ParseQuery(string(body))
+	require.NoError(t, err)
+	require.Equal(t, "fast", form.Get("adhoc_search_level"))
+	require.Equal(t, "search index=_internal | stats count", form.Get("search"))
+}
+
+func TestMakeRequestRetryBudget(t *testing.T) {
+	var hits atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		// the body of retried searches is sent again
+		if body, _ := io.ReadAll(r.Body); string(body) != "search=search index=_internal" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: ts.URL,
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+		Retries: Retries{MaxRetries: 2, Budget: 3},
+	}
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	scrape := func() {
+		for i := 0; i < 10; i++ {
+			req, err := client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+			require.NoError(t, err)
+			_, err = client.makeRequest(req)
+			require.ErrorIs(t, err, errServer)
+		}
+	}
+
+	// ten failing requests allowed two retries each are only retried three times in total
+	scrape()
+	require.Equal(t, int64(10+3), hits.Load())
+
+	// the budget is refilled for the next scrape
+	hits.Store(0)
+	client.resetRetryBudget()
+	scrape()
+	require.Equal(t, int64(10+3), hits.Load())
+}
+
+// records the requests it sees before handing them to the wrapped transport
+type recordingRoundTripper struct {
+	next http.RoundTripper
+	mu   sync.Mutex
+	seen []string
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.seen = append(r.seen, req.Method+" "+req.URL.RequestURI())
+	r.mu.Unlock()
+	return r.next.RoundTrip(req)
+}
+
+func TestClientTransportWrapper(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	rec := &recordingRoundTripper{}
+	wrap := func(next http.RoundTripper) http.RoundTripper {
+		rec.next = next
+		return rec
+	}
+	client, err := newSplunkEntClient(createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{}), host, componenttest.NewNopTelemetrySettings(), wrap)
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	req, err := client.createAPIRequest(ctx, "/services/server/info?output_mode=json")
+	require.NoError(t, err)
+	res, err := client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	req, err = client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+	require.NoError(t, err)
+	res, err = client.makeRequest(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	require.Equal(t, []string{
+		"GET /services/server/info?output_mode=json",
+		"POST /services/search/jobs/",
+	}, rec.seen)
+}
+
+func TestClientAuthModes(t *testing.T) {
+	var mu sync.Mutex
+	var headers []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// stands in for basicauth, setting the credentials of every request
+	basic := auth.NewClient(auth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+		return &basicAuthRoundTripper{next: base}, nil
+	}))
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): basic,
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		token    configopaque.String
+		expected string
+	}{
+		{desc: "auth extension", expected: "Basic YWRtaW46Y2hhbmdlbWU="},
+		{desc: "auth token", token: "eyJraWQiOiJzcGx1bmsuc2VjcmV0", expected: "Bearer eyJraWQiOiJzcGx1bmsuc2VjcmV0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			headers = nil
+			cfg := createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{})
+			if test.token != "" {
+				cfg.IdxEndpoint.Auth = nil
+				cfg.AuthToken = test.token
+			}
+			client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+			reqs := []func() (*http.Request, error){
+				func() (*http.Request, error) {
+					return client.createAPIRequest(ctx, "/services/server/info?output_mode=json")
+				},
+				func() (*http.Request, error) {
+					return client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+				},
+			}
+			for _, create := range reqs {
+				req, err := create()
+				require.NoError(t, err)
+				res, err := client.makeRequest(req)
+				require.NoError(t, err)
+				res.Body.Close()
+			}
+
+			require.Equal(t, []string{test.expected, test.expected}, headers)
+		})
+	}
+}
+
+type basicAuthRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *basicAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth("admin", "changeme")
+	return rt.next.RoundTrip(req)
+}
+
+func TestClientSessionAuth(t *testing.T) {
+	var mu sync.Mutex
+	var logins int
+	var valid string
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/services/auth/login" {
+			form, _ := url.ParseQuery(string(body))
+			if form.Get("username") != "admin" || form.Get("password") != "changeme" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			valid = fmt.Sprintf("key%d", logins)
+			_, _ = fmt.Fprintf(w, `<response><sessionKey>%s</sessionKey></response>`, valid)
+			return
+		}
+		if r.Header.Get("Authorization") != "Splunk "+valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	cfg := createMockConfig(typeIdx, ts.URL, metadata.MetricsBuilderConfig{})
+	cfg.IdxEndpoint.Auth = nil
+	cfg.SessionAuth = &SessionAuth{Username: "admin", Password: "changeme"}
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NoError(t, client.login(context.Background()))
+
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeIdx)
+	dispatch := func() {
+		req, err := client.createRequest(ctx, &searchResponse{search: "search=search index=_internal"})
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+		res.Body.Close()
+	}
+
+	dispatch()
+	require.Equal(t, 1, logins)
+
+	// the session key expires, so the next request logs in again and is sent once more with its body
+	mu.Lock()
+	valid = "expired"
+	mu.Unlock()
+	dispatch()
+	require.Equal(t, 2, logins)
+	require.Equal(t, "key2", client.session.key(typeIdx))
+	require.Equal(t, []string{"search=search index=_internal", "search=search index=_internal"}, bodies)
+}
+
+func TestClientAdditionalIndexers(t *testing.T) {
+	// each indexer hands out a session key of its own and only accepts that one
+	newIndexer := func(key string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/services/auth/login" {
+				_, _ = fmt.Fprintf(w, `<response><sessionKey>%s</sessionKey></response>`, key)
+				return
+			}
+			if r.Header.Get("Authorization") != "Splunk "+key {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(key))
+		}))
+	}
+	idx1 := newIndexer("key1")
+	defer idx1.Close()
+	idx2 := newIndexer("key2")
+	defer idx2.Close()
+	idx3 := newIndexer("key3")
+	defer idx3.Close()
+
+	cfg := createMockConfig(typeIdx, idx1.URL, metadata.MetricsBuilderConfig{})
+	cfg.IdxEndpoint.Auth = nil
+	cfg.AdditionalIndexers = []confighttp.ClientConfig{{Endpoint: idx2.URL}, {Endpoint: idx3.URL}}
+	cfg.SessionAuth = &SessionAuth{Username: "admin", Password: "changeme"}
+	client, err := newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NoError(t, client.login(context.Background()))
+
+	var keys []string
+	for _, ctx := range client.indexerContexts(context.Background()) {
+		require.Equal(t, typeIdx, ctx.Value(endpointType("type")))
+		req, err := client.createAPIRequest(ctx, apiDict[`SplunkDataIndexesExtended`])
+		require.NoError(t, err)
+		res, err := client.makeRequest(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		require.NoError(t, err)
+		keys = append(keys, string(body))
+	}
+	require.Equal(t, []string{"key1", "key2", "key3"}, keys)
+
+	// without the indexer there are no indexers to send requests to
+	cfg = createMockConfig(typeSh, idx1.URL, metadata.MetricsBuilderConfig{})
+	cfg.SHEndpoint.Auth = nil
+	client, err = newSplunkEntClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.Empty(t, client.indexerContexts(context.Background()))
+}
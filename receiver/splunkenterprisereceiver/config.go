@@ -4,13 +4,20 @@
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
 import (
+	_ "embed"
 	"errors"
+	"net"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
@@ -18,7 +25,32 @@ import (
 var (
 	errBadOrMissingEndpoint = errors.New("missing a valid endpoint")
 	errBadScheme            = errors.New("endpoint scheme must be either http or https")
-	errMissingAuthExtension = errors.New("auth extension missing from config")
+	errMissingAuthExtension = errors.New("either an auth extension or auth_token is required")
+	errBothAuth             = errors.New("an auth extension, auth_token and session_auth are mutually exclusive")
+	errBadSessionAuth       = errors.New("session_auth requires a username")
+	errBadMaxSearches       = errors.New("max_concurrent_searches must not be negative")
+	errBadSkewTolerance     = errors.New("clock_skew_tolerance must not be negative")
+	errBadRequestTimeouts   = errors.New("request_timeouts must not be negative")
+	errBadDeduplication     = errors.New("deduplicate_data_points must be one of last_wins or sum")
+	errBadAttributeFilter   = errors.New("attribute_filters may either keep or drop the attributes of a metric, not both")
+	errUnknownSearch        = errors.New("search_attribute_fields refers to an unknown search")
+	errBadMaxScrapeDuration = errors.New("max_scrape_duration must not be negative")
+	errBadMaxScrapes        = errors.New("max_concurrent_scrapes must not be negative")
+	errMissingSearchVar     = errors.New("search_variables is missing a variable used by the search of an enabled metric")
+	errBadIndexDiscovery    = errors.New("index_discovery_interval must not be negative")
+	errBadRetries           = errors.New("retries must not be negative")
+	errBadSilentThreshold   = errors.New("silent_index_threshold must not be negative")
+	errBadResultDelay       = errors.New("initial_result_delays must refer to known searches and must not be negative")
+	errBadEmptyResultRetry  = errors.New("empty_result_retry_delays must refer to known searches and must be positive")
+	errBadStateFields       = errors.New("state_fields must refer to known searches")
+	errBadSearchMode        = errors.New("search_mode must be one of fast, smart or verbose")
+	errBadIndexConfigReload = errors.New("index_config_refresh_interval must not be negative")
+	errBadUnitOverride      = errors.New("unit_overrides must map known metric names to a unit of data size or of time")
+	errUnitOverrideMismatch = errors.New("unit_overrides must convert metrics to a unit of the same dimension as their default unit")
+	errBadChunkSize         = errors.New("results_chunk_size must not be negative")
+	errBadSampling          = errors.New("sampling must map metric names to a probability between 0 and 1")
+	errAdditionalIndexers   = errors.New("additional_indexers require the indexer endpoint to be set")
+	errBadPollInterval      = errors.New("search_poll_initial_interval must be positive and search_poll_max_interval at least as long, but at most half of the scrape timeout")
 )
 
 type Config struct {
@@ -27,6 +59,270 @@ type Config struct {
 	IdxEndpoint                             confighttp.ClientConfig `mapstructure:"indexer"`
 	SHEndpoint                              confighttp.ClientConfig `mapstructure:"search_head"`
 	CMEndpoint                              confighttp.ClientConfig `mapstructure:"cluster_master"`
+	// AdditionalIndexers are further indexers of the tier, which the index metrics read from the indexer API
+	// are fetched from along with the indexer endpoint. The entries each indexer returns for the same index
+	// are merged into one. The indexer endpoint must be set along with them.
+	AdditionalIndexers []confighttp.ClientConfig `mapstructure:"additional_indexers"`
+	// AuthToken is a Splunk authentication token sent as a bearer token to every endpoint, in place of an
+	// auth extension. Splunk Cloud in particular favors tokens over username and password.
+	AuthToken configopaque.String `mapstructure:"auth_token"`
+	// SessionAuth logs in to the indexer, search head and cluster master endpoints with a username and
+	// password when the receiver starts, and authenticates requests with the session keys obtained, in place
+	// of an auth extension. The Admin Config Service does not support it.
+	SessionAuth *SessionAuth `mapstructure:"session_auth"`
+	// ACSEndpoint is the Admin Config Service of a Splunk Cloud stack, https://admin.splunk.com/{stack}.
+	// Only the metrics ACS exposes are scraped from it.
+	ACSEndpoint confighttp.ClientConfig `mapstructure:"acs"`
+	// MaxConcurrentSearches limits the number of search jobs outstanding at once against each endpoint, so
+	// that receivers stay under the search quota of the configured user's role. The limit is shared by all
+	// receivers targeting the same endpoint. 0 means no limit.
+	MaxConcurrentSearches int `mapstructure:"max_concurrent_searches"`
+	// MaxConcurrentScrapes is the number of metric scrapes run at once within a collection interval. Each
+	// search based scrape may wait up to the timeout on its search job, so running several at once shortens
+	// the scrape as a whole. 0 and 1 run them one after another.
+	MaxConcurrentScrapes int `mapstructure:"max_concurrent_scrapes"`
+	// ResultsChunkSize is the number of result rows read per request once a search completes, bounding the
+	// memory each request takes for searches returning many rows. 0 reads all of them at once.
+	ResultsChunkSize int `mapstructure:"results_chunk_size"`
+	// SearchPollInitialInterval is how long to wait before polling a search job again when its results are not
+	// ready. The wait doubles with every poll up to SearchPollMaxInterval, so that fast searches are answered
+	// quickly without slow ones being polled as often. Lower values mean more requests against the search head.
+	SearchPollInitialInterval time.Duration `mapstructure:"search_poll_initial_interval"`
+	SearchPollMaxInterval     time.Duration `mapstructure:"search_poll_max_interval"`
+	// UseServerTime timestamps data points using the clock of the Splunk server rather than the clock of
+	// the collector so that they line up with events on the Splunk side.
+	UseServerTime bool `mapstructure:"use_server_time"`
+	// ClockSkewTolerance is how far the collector's clock may drift from the Splunk server's clock before a
+	// warning is logged. Only used when UseServerTime is enabled.
+	ClockSkewTolerance time.Duration `mapstructure:"clock_skew_tolerance"`
+	// RequestTimeouts bound the individual phases of every request made to Splunk. They apply on top of
+	// the overall timeout configured for each endpoint.
+	RequestTimeouts RequestTimeouts `mapstructure:"request_timeouts"`
+	// Retries configures how requests failing with a transient error are retried.
+	Retries Retries `mapstructure:"retries"`
+	// DeduplicateDataPoints merges data points of a metric that share the same attributes within a single
+	// scrape, keeping either the last value seen (last_wins) or the sum of all values (sum). Left empty,
+	// duplicate data points are emitted as is.
+	DeduplicateDataPoints string `mapstructure:"deduplicate_data_points"`
+	// CAPath is a PEM file, or a directory of PEM files, holding additional certificate authorities to
+	// trust when connecting to any endpoint. They are added to the system trust store and to any CA
+	// configured on the endpoint itself.
+	CAPath string `mapstructure:"ca_path"`
+	// DisableHTTP2 makes the requests to every endpoint use HTTP/1.1 even where the Splunk server supports
+	// HTTP/2.
+	DisableHTTP2 bool `mapstructure:"disable_http2"`
+	// AttributeFilters, keyed by metric name, remove attributes from the data points of a metric to bound
+	// its cardinality. Data points left with the same attributes are summed into a single data point.
+	AttributeFilters map[string]AttributeFilter `mapstructure:"attribute_filters"`
+	// SearchAttributeFields, keyed by search name, maps the result fields a search function reads its
+	// attributes from (host, indexname, ...) to the fields that actually hold them in the search results.
+	SearchAttributeFields map[string]map[string]string `mapstructure:"search_attribute_fields"`
+	// SearchableTestIndexes lists the indexes a test search is run against on every scrape to verify that
+	// they can actually be searched. Each index costs a search job, so none are tested by default.
+	SearchableTestIndexes []string `mapstructure:"searchable_test_indexes"`
+	// WarmupSearch is dispatched to every endpoint when the receiver starts, priming the caches of the
+	// Splunk instances so that the first scrape after a restart does not time out.
+	WarmupSearch string `mapstructure:"warmup_search"`
+	// MaxScrapeDuration caps the time a whole scrape may take. Once exceeded no further metrics are scraped
+	// and the scrape returns what was gathered so far. 0 means no limit.
+	MaxScrapeDuration time.Duration `mapstructure:"max_scrape_duration"`
+	// SearchVariables are substituted into the {{.Name}} placeholders of the built-in searches, such as
+	// the mount point whose IOPS are reported.
+	SearchVariables map[string]string `mapstructure:"search_variables"`
+	// IndexDiscoverySearch resolves the indexes to report metrics for. It is run when the receiver starts
+	// and must return the index names in a field named index. Left empty, every index is reported.
+	IndexDiscoverySearch string `mapstructure:"index_discovery_search"`
+	// IndexDiscoveryInterval is how often IndexDiscoverySearch is run again to pick up new indexes. 0 means
+	// it only runs when the receiver starts.
+	IndexDiscoveryInterval time.Duration `mapstructure:"index_discovery_interval"`
+	// IncludeInternalIndexes reports the data points of the internal indexes, whose names start with an
+	// underscore (_internal, _audit, _introspection, ...). They are dropped by default.
+	IncludeInternalIndexes bool `mapstructure:"include_internal_indexes"`
+	// SilentIndexThreshold is how long an index may go without receiving data before it is counted as silent.
+	SilentIndexThreshold time.Duration `mapstructure:"silent_index_threshold"`
+	// InitialResultDelays, keyed by search name, is how long to wait after dispatching a search before
+	// polling for its results. Searches known to take several seconds are otherwise polled in vain.
+	InitialResultDelays map[string]time.Duration `mapstructure:"initial_result_delays"`
+	// EmptyResultRetryDelays, keyed by search name, is how long to wait before dispatching a search again when
+	// it completes without results, as happens when the data of its time window has not fully landed. The
+	// search is retried for as long as it can complete within the scrape timeout.
+	EmptyResultRetryDelays map[string]time.Duration `mapstructure:"empty_result_retry_delays"`
+	// ResourcePerHost emits the data points of each Splunk host under a resource of their own, moving the
+	// host from the splunk.host data point attribute to the host.name resource attribute.
+	ResourcePerHost bool `mapstructure:"resource_per_host"`
+	// CaptainOnly scrapes the metrics which describe a search head cluster as a whole only when the search
+	// head endpoint is the captain of its cluster, so that scraping every member does not duplicate them.
+	CaptainOnly bool `mapstructure:"captain_only"`
+	// StateFields, keyed by search name and then by result field, maps the enumerated string values of a
+	// field (green, yellow, red, ...) to the integers reported for them by splunk.receiver.search.field.state.
+	StateFields map[string]map[string]map[string]int64 `mapstructure:"state_fields"`
+	// UseResultTime timestamps the data points of a search result row with the _time field of the row, when
+	// the search returns one, rather than with the time of the scrape.
+	UseResultTime bool `mapstructure:"use_result_time"`
+	// SearchMode is the adhoc_search_level the searches are dispatched with. fast skips the field discovery
+	// the built-in searches have no use for, lightening the load they put on the search head.
+	SearchMode string `mapstructure:"search_mode"`
+	// AttributeNormalizations, keyed by attribute name, rewrites the values of an attribute so that a host or
+	// index reported differently by different searches forms a single series.
+	AttributeNormalizations map[string]AttributeNormalization `mapstructure:"attribute_normalizations"`
+	// IndexConfigRefreshInterval is how often the full configuration of every index is fetched again. In
+	// between, only the lighter indexes-extended listing is fetched to pick up new indexes. 0 means the full
+	// configuration is fetched on every scrape.
+	IndexConfigRefreshInterval time.Duration `mapstructure:"index_config_refresh_interval"`
+	// DiagnosticsDir is a directory the requests of each scrape, with their responses and timings, are
+	// written to as a JSON file with credentials redacted, to be attached to support cases.
+	DiagnosticsDir string `mapstructure:"diagnostics_dir"`
+	// Sampling, keyed by metric name, is the probability of a metric being collected on each collection
+	// interval. A metric left out of an interval is not reported, and its search is not run unless another
+	// metric needs it, trading resolution for load on Splunk. Metrics without a probability are always collected.
+	Sampling map[string]float64 `mapstructure:"sampling"`
+	// UnitOverrides, keyed by metric name, is the unit a metric is emitted in instead of its default unit, its
+	// values scaled to match. Only conversions between units of data size or between units of time are made.
+	UnitOverrides map[string]string `mapstructure:"unit_overrides"`
+}
+
+// AttributeFilter selects the attributes retained on the data points of a metric. Only one of Keep or Drop
+// may be set.
+type AttributeFilter struct {
+	// Keep lists the only attributes retained.
+	Keep []string `mapstructure:"keep"`
+	// Drop lists the attributes removed.
+	Drop []string `mapstructure:"drop"`
+}
+
+func (f AttributeFilter) retains(attr string) bool {
+	if len(f.Keep) > 0 {
+		return slices.Contains(f.Keep, attr)
+	}
+	return !slices.Contains(f.Drop, attr)
+}
+
+// AttributeNormalization configures how the values of an attribute are rewritten.
+type AttributeNormalization struct {
+	// Lowercase lowercases the values.
+	Lowercase bool `mapstructure:"lowercase"`
+	// StripDomain removes the domain of fully qualified host names, keeping everything up to the first dot.
+	// IP addresses are left as is.
+	StripDomain bool `mapstructure:"strip_domain"`
+}
+
+func (n AttributeNormalization) apply(v string) string {
+	if n.StripDomain && net.ParseIP(v) == nil {
+		v, _, _ = strings.Cut(v, ".")
+	}
+	if n.Lowercase {
+		v = strings.ToLower(v)
+	}
+	return v
+}
+
+// The units metrics can be converted between, each with the dimension it measures and its size in the base
+// unit of that dimension
+var unitScales = map[string]struct {
+	dimension string
+	scale     float64
+}{
+	"By":   {"size", 1},
+	"KBy":  {"size", 1e3},
+	"MBy":  {"size", 1e6},
+	"GBy":  {"size", 1e9},
+	"TBy":  {"size", 1e12},
+	"KiBy": {"size", 1 << 10},
+	"MiBy": {"size", 1 << 20},
+	"GiBy": {"size", 1 << 30},
+	"TiBy": {"size", 1 << 40},
+	"ns":   {"time", 1e-9},
+	"us":   {"time", 1e-6},
+	"ms":   {"time", 1e-3},
+	"s":    {"time", 1},
+	"min":  {"time", 60},
+	"h":    {"time", 3600},
+	"d":    {"time", 86400},
+}
+
+//go:embed metadata.yaml
+var metadataYAML []byte
+
+// The default unit of each metric, as declared in metadata.yaml
+var metricUnits = sync.OnceValue(func() map[string]string {
+	var md struct {
+		Metrics map[string]struct {
+			Unit string `yaml:"unit"`
+		} `yaml:"metrics"`
+	}
+	if err := yaml.Unmarshal(metadataYAML, &md); err != nil {
+		panic(err)
+	}
+	units := make(map[string]string, len(md.Metrics))
+	for name, m := range md.Metrics {
+		units[name] = m.Unit
+	}
+	return units
+})
+
+// Retries configures how requests failing because of a timeout, a server error or a transport failure are
+// retried. Retries are disabled by default.
+type Retries struct {
+	// MaxRetries is the number of times a single request is retried.
+	MaxRetries int `mapstructure:"max_retries"`
+	// Backoff is the time waited before each retry.
+	Backoff time.Duration `mapstructure:"backoff"`
+	// Budget bounds the retries of all requests made during a scrape, so that a degraded Splunk is not hit
+	// by the retries of every metric at once. 0 means the retries are only bounded by MaxRetries.
+	Budget int `mapstructure:"budget"`
+}
+
+const (
+	dedupLastWins = "last_wins"
+	dedupSum      = "sum"
+)
+
+const (
+	searchModeFast    = "fast"
+	searchModeSmart   = "smart"
+	searchModeVerbose = "verbose"
+)
+
+// SessionAuth holds the credentials the receiver logs in with through services/auth/login.
+type SessionAuth struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+}
+
+// RequestTimeouts configures how long each phase of a request may take. A value of 0 leaves the phase
+// bounded only by the overall timeout of the endpoint.
+type RequestTimeouts struct {
+	// Connect bounds establishing the TCP connection.
+	Connect time.Duration `mapstructure:"connect"`
+	// TLSHandshake bounds the TLS handshake.
+	TLSHandshake time.Duration `mapstructure:"tls_handshake"`
+	// ResponseHeader bounds the time between writing the request and receiving the response headers.
+	ResponseHeader time.Duration `mapstructure:"response_header"`
+	// Read bounds reading the response body once the headers have been received.
+	Read time.Duration `mapstructure:"read"`
+}
+
+// Checks that an endpoint is authenticated by exactly one of its auth extension, the auth token or, unless
+// the endpoint does not support logging in, the session auth.
+func (cfg *Config) validateAuth(hc confighttp.ClientConfig, login bool) error {
+	modes := 0
+	if hc.Auth != nil {
+		modes++
+	}
+	if cfg.AuthToken != "" {
+		modes++
+	}
+	if login && cfg.SessionAuth != nil {
+		modes++
+	}
+
+	switch {
+	case modes == 0:
+		return errMissingAuthExtension
+	case modes > 1:
+		return errBothAuth
+	}
+	return nil
 }
 
 func (cfg *Config) Validate() (errors error) {
@@ -37,27 +333,41 @@ func (cfg *Config) Validate() (errors error) {
 	// if no endpoint is set we do not start the receiver. For each set endpoint we go through and Validate
 	// that it contains an auth setting and a valid endpoint, if its missing either of these the receiver will
 	// fail to start.
-	if cfg.IdxEndpoint.Endpoint == "" && cfg.SHEndpoint.Endpoint == "" && cfg.CMEndpoint.Endpoint == "" {
+	if cfg.IdxEndpoint.Endpoint == "" && cfg.SHEndpoint.Endpoint == "" && cfg.CMEndpoint.Endpoint == "" && cfg.ACSEndpoint.Endpoint == "" {
 		errors = multierr.Append(errors, errBadOrMissingEndpoint)
 	} else {
 		if cfg.IdxEndpoint.Endpoint != "" {
-			if cfg.IdxEndpoint.Auth == nil {
-				errors = multierr.Append(errors, errMissingAuthExtension)
+			if err = cfg.validateAuth(cfg.IdxEndpoint, true); err != nil {
+				errors = multierr.Append(errors, err)
 			}
 			endpoints = append(endpoints, cfg.IdxEndpoint.Endpoint)
+			for _, hcs := range cfg.AdditionalIndexers {
+				if err = cfg.validateAuth(hcs, true); err != nil {
+					errors = multierr.Append(errors, err)
+				}
+				endpoints = append(endpoints, hcs.Endpoint)
+			}
+		} else if len(cfg.AdditionalIndexers) > 0 {
+			errors = multierr.Append(errors, errAdditionalIndexers)
 		}
 		if cfg.SHEndpoint.Endpoint != "" {
-			if cfg.SHEndpoint.Auth == nil {
-				errors = multierr.Append(errors, errMissingAuthExtension)
+			if err = cfg.validateAuth(cfg.SHEndpoint, true); err != nil {
+				errors = multierr.Append(errors, err)
 			}
 			endpoints = append(endpoints, cfg.SHEndpoint.Endpoint)
 		}
 		if cfg.CMEndpoint.Endpoint != "" {
-			if cfg.CMEndpoint.Auth == nil {
-				errors = multierr.Append(errors, errMissingAuthExtension)
+			if err = cfg.validateAuth(cfg.CMEndpoint, true); err != nil {
+				errors = multierr.Append(errors, err)
 			}
 			endpoints = append(endpoints, cfg.CMEndpoint.Endpoint)
 		}
+		if cfg.ACSEndpoint.Endpoint != "" {
+			if err = cfg.validateAuth(cfg.ACSEndpoint, false); err != nil {
+				errors = multierr.Append(errors, err)
+			}
+			endpoints = append(endpoints, cfg.ACSEndpoint.Endpoint)
+		}
 
 		for _, e := range endpoints {
 			targetURL, err = url.Parse(e)
@@ -73,5 +383,155 @@ func (cfg *Config) Validate() (errors error) {
 		}
 	}
 
+	if cfg.SessionAuth != nil && cfg.SessionAuth.Username == "" {
+		errors = multierr.Append(errors, errBadSessionAuth)
+	}
+
+	if cfg.ResultsChunkSize < 0 {
+		errors = multierr.Append(errors, errBadChunkSize)
+	}
+
+	if cfg.MaxConcurrentSearches < 0 {
+		errors = multierr.Append(errors, errBadMaxSearches)
+	}
+
+	if cfg.MaxConcurrentScrapes < 0 {
+		errors = multierr.Append(errors, errBadMaxScrapes)
+	}
+
+	if cfg.SearchPollInitialInterval <= 0 || cfg.SearchPollMaxInterval < cfg.SearchPollInitialInterval ||
+		(cfg.ScraperControllerSettings.Timeout > 0 && 2*cfg.SearchPollMaxInterval > cfg.ScraperControllerSettings.Timeout) {
+		errors = multierr.Append(errors, errBadPollInterval)
+	}
+
+	if cfg.ClockSkewTolerance < 0 {
+		errors = multierr.Append(errors, errBadSkewTolerance)
+	}
+
+	if cfg.MaxScrapeDuration < 0 {
+		errors = multierr.Append(errors, errBadMaxScrapeDuration)
+	}
+
+	if cfg.IndexDiscoveryInterval < 0 {
+		errors = multierr.Append(errors, errBadIndexDiscovery)
+	}
+
+	if cfg.IndexConfigRefreshInterval < 0 {
+		errors = multierr.Append(errors, errBadIndexConfigReload)
+	}
+
+	sampled := make(map[string]bool, len(cfg.Sampling))
+	for name, p := range cfg.Sampling {
+		if p < 0 || p > 1 {
+			errors = multierr.Append(errors, errBadSampling)
+		}
+		sampled[name] = true
+	}
+	if len(sampled) > 0 {
+		var mc metadata.MetricsConfig
+		if err = disableMetrics(&mc, sampled); err != nil {
+			errors = multierr.Append(errors, errBadSampling)
+		}
+	}
+
+	overridden := make(map[string]bool, len(cfg.UnitOverrides))
+	var badUnit, mismatch bool
+	for name, unit := range cfg.UnitOverrides {
+		overridden[name] = true
+		to, ok := unitScales[unit]
+		if !ok || name == "" {
+			badUnit = true
+			continue
+		}
+		// unknown metrics are left to disableMetrics below
+		if def, ok := metricUnits()[name]; ok {
+			if from, ok := unitScales[def]; !ok || from.dimension != to.dimension {
+				mismatch = true
+			}
+		}
+	}
+	if len(overridden) > 0 {
+		var mc metadata.MetricsConfig
+		if err = disableMetrics(&mc, overridden); err != nil {
+			badUnit = true
+		}
+	}
+	if badUnit {
+		errors = multierr.Append(errors, errBadUnitOverride)
+	}
+	if mismatch {
+		errors = multierr.Append(errors, errUnitOverrideMismatch)
+	}
+
+	if cfg.SilentIndexThreshold < 0 {
+		errors = multierr.Append(errors, errBadSilentThreshold)
+	}
+
+	if cfg.Retries.MaxRetries < 0 || cfg.Retries.Backoff < 0 || cfg.Retries.Budget < 0 {
+		errors = multierr.Append(errors, errBadRetries)
+	}
+
+	rt := cfg.RequestTimeouts
+	if rt.Connect < 0 || rt.TLSHandshake < 0 || rt.ResponseHeader < 0 || rt.Read < 0 {
+		errors = multierr.Append(errors, errBadRequestTimeouts)
+	}
+
+	for _, f := range cfg.AttributeFilters {
+		if len(f.Keep) > 0 && len(f.Drop) > 0 {
+			errors = multierr.Append(errors, errBadAttributeFilter)
+			break
+		}
+	}
+
+	for name := range cfg.SearchAttributeFields {
+		if _, ok := searchDict[name]; !ok {
+			errors = multierr.Append(errors, errUnknownSearch)
+			break
+		}
+	}
+
+	for name, enabled := range templatedSearches {
+		if !enabled(cfg.MetricsBuilderConfig.Metrics) {
+			continue
+		}
+		if _, err = renderSearch(searchDict[name], cfg.SearchVariables); err != nil {
+			errors = multierr.Append(errors, errMissingSearchVar)
+			break
+		}
+	}
+
+	for name, delay := range cfg.InitialResultDelays {
+		if _, ok := searchDict[name]; !ok || delay < 0 {
+			errors = multierr.Append(errors, errBadResultDelay)
+			break
+		}
+	}
+
+	for name, delay := range cfg.EmptyResultRetryDelays {
+		if _, ok := searchDict[name]; !ok || delay <= 0 {
+			errors = multierr.Append(errors, errBadEmptyResultRetry)
+			break
+		}
+	}
+
+	for name := range cfg.StateFields {
+		if _, ok := searchDict[name]; !ok {
+			errors = multierr.Append(errors, errBadStateFields)
+			break
+		}
+	}
+
+	switch cfg.DeduplicateDataPoints {
+	case "", dedupLastWins, dedupSum:
+	default:
+		errors = multierr.Append(errors, errBadDeduplication)
+	}
+
+	switch cfg.SearchMode {
+	case "", searchModeFast, searchModeSmart, searchModeVerbose:
+	default:
+		errors = multierr.Append(errors, errBadSearchMode)
+	}
+
 	return errors
 }
@@ -6,12 +6,14 @@ package splunkenterprisereceiver // import "github.com/open-telemetry/openteleme
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
@@ -91,10 +93,373 @@ func TestEndpointCorrectness(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc:     "auth token along with an auth extension",
+			expected: errBothAuth,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				AuthToken: "eyJraWQiOiJzcGx1bmsuc2VjcmV0",
+			},
+		},
+		{
+			desc:     "session auth along with an auth token",
+			expected: errBothAuth,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				AuthToken:   "eyJraWQiOiJzcGx1bmsuc2VjcmV0",
+				SessionAuth: &SessionAuth{Username: "admin", Password: "changeme"},
+			},
+		},
+		{
+			desc:     "session auth without a username",
+			expected: errBadSessionAuth,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SessionAuth: &SessionAuth{Password: "changeme"},
+			},
+		},
+		{
+			desc:     "additional indexers without the indexer endpoint",
+			expected: errAdditionalIndexers,
+			config: &Config{
+				SHEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				AdditionalIndexers: []confighttp.ClientConfig{{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.3:2093",
+				}},
+			},
+		},
+		{
+			desc:     "additional indexer has bad scheme",
+			expected: errBadScheme,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				AdditionalIndexers: []confighttp.ClientConfig{{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "gss://123.124.32.12:90",
+				}},
+			},
+		},
+		{
+			desc:     "negative max concurrent searches",
+			expected: errBadMaxSearches,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				MaxConcurrentSearches: -1,
+			},
+		},
+		{
+			desc:     "negative results chunk size",
+			expected: errBadChunkSize,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				ResultsChunkSize: -1,
+			},
+		},
+		{
+			desc:     "negative max concurrent scrapes",
+			expected: errBadMaxScrapes,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				MaxConcurrentScrapes: -1,
+			},
+		},
+		{
+			desc:     "unit override to an unknown unit",
+			expected: errBadUnitOverride,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				UnitOverrides: map[string]string{"splunk.license.index.usage": "megabytes"},
+			},
+		},
+		{
+			desc:     "unit override of an unknown metric",
+			expected: errBadUnitOverride,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				UnitOverrides: map[string]string{"splunk.unknown": "MBy"},
+			},
+		},
+		{
+			desc:     "unit override to a unit of another dimension",
+			expected: errUnitOverrideMismatch,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				UnitOverrides: map[string]string{"splunk.license.index.usage": "s"},
+			},
+		},
+		{
+			desc:     "unit override of a metric without a convertible unit",
+			expected: errUnitOverrideMismatch,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				UnitOverrides: map[string]string{"splunk.server.introspection.queues.current": "s"},
+			},
+		},
+		{
+			desc:     "negative initial search poll interval",
+			expected: errBadPollInterval,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SearchPollInitialInterval: -time.Second,
+			},
+		},
+		{
+			desc:     "max search poll interval close to the scrape timeout",
+			expected: errBadPollInterval,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{Timeout: 10 * time.Second},
+				SearchPollMaxInterval:     8 * time.Second,
+			},
+		},
+		{
+			desc:     "max search poll interval shorter than the initial interval",
+			expected: errBadPollInterval,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SearchPollInitialInterval: time.Second,
+				SearchPollMaxInterval:     500 * time.Millisecond,
+			},
+		},
+		{
+			desc:     "sampling probability above 1",
+			expected: errBadSampling,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				Sampling: map[string]float64{"splunk.license.index.usage": 2},
+			},
+		},
+		{
+			desc:     "sampling of an unknown metric",
+			expected: errBadSampling,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				Sampling: map[string]float64{"splunk.no.such.metric": 0.5},
+			},
+		},
+		{
+			desc:     "negative max scrape duration",
+			expected: errBadMaxScrapeDuration,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				MaxScrapeDuration: -time.Second,
+			},
+		},
+		{
+			desc:     "negative request timeout",
+			expected: errBadRequestTimeouts,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				RequestTimeouts: RequestTimeouts{Read: -time.Second},
+			},
+		},
+		{
+			desc:     "unknown deduplication mode",
+			expected: errBadDeduplication,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				DeduplicateDataPoints: "average",
+			},
+		},
+		{
+			desc:     "attribute filter both keeping and dropping",
+			expected: errBadAttributeFilter,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				AttributeFilters: map[string]AttributeFilter{
+					"splunk.ingestion.errors": {Keep: []string{"splunk.component"}, Drop: []string{"splunk.host"}},
+				},
+			},
+		},
+		{
+			desc:     "attribute fields of an unknown search",
+			expected: errUnknownSearch,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SearchAttributeFields: map[string]map[string]string{
+					"SplunkNoSuchSearch": {"host": "splunk_server"},
+				},
+			},
+		},
+		{
+			desc:     "negative index discovery interval",
+			expected: errBadIndexDiscovery,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				IndexDiscoverySearch:   "| rest /services/data/indexes | rename title as index | fields index",
+				IndexDiscoveryInterval: -time.Minute,
+			},
+		},
+		{
+			desc:     "negative retries",
+			expected: errBadRetries,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				Retries: Retries{MaxRetries: 3, Budget: -1},
+			},
+		},
+		{
+			desc:     "negative silent index threshold",
+			expected: errBadSilentThreshold,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SilentIndexThreshold: -time.Hour,
+			},
+		},
+		{
+			desc:     "initial result delay of an unknown search",
+			expected: errBadResultDelay,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				InitialResultDelays: map[string]time.Duration{"SplunkNoSuchSearch": time.Second},
+			},
+		},
+		{
+			desc:     "empty result retry without a delay",
+			expected: errBadEmptyResultRetry,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				EmptyResultRetryDelays: map[string]time.Duration{"SplunkIngestionErrors": 0},
+			},
+		},
+		{
+			desc:     "state fields of an unknown search",
+			expected: errBadStateFields,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				StateFields: map[string]map[string]map[string]int64{
+					"SplunkNoSuchSearch": {"health": {"green": 0, "red": 2}},
+				},
+			},
+		},
+		{
+			desc:     "unknown search mode",
+			expected: errBadSearchMode,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				SearchMode: "turbo",
+			},
+		},
+		{
+			desc:     "negative index config refresh interval",
+			expected: errBadIndexConfigReload,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				IndexConfigRefreshInterval: -time.Hour,
+			},
+		},
+		{
+			desc:     "search variable missing for an enabled metric",
+			expected: errMissingSearchVar,
+			config: &Config{
+				IdxEndpoint: confighttp.ClientConfig{
+					Auth:     &configauth.Authentication{AuthenticatorID: dummyID},
+					Endpoint: "https://123.123.32.2:2093",
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				SearchVariables:      map[string]string{"SampleIndex": "main"},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			// cases about other settings poll searches at the default intervals
+			if test.config.SearchPollInitialInterval == 0 {
+				test.config.SearchPollInitialInterval = defaultSearchPollInitial
+			}
+			if test.config.SearchPollMaxInterval == 0 {
+				test.config.SearchPollMaxInterval = defaultSearchPollMax
+			}
 			err := test.config.Validate()
 			t.Logf("%v\n", err)
 			require.Error(t, err)
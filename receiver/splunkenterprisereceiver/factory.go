@@ -18,8 +18,16 @@ import (
 )
 
 const (
-	defaultInterval          = 10 * time.Minute
-	defaultMaxSearchWaitTime = 60 * time.Second
+	defaultInterval           = 10 * time.Minute
+	defaultMaxSearchWaitTime  = 60 * time.Second
+	defaultClockSkewTolerance = 5 * time.Second
+	defaultMountPoint         = "/opt/splunk/var"
+	defaultLongRunningSearch  = "300"
+	defaultSilentThreshold    = 24 * time.Hour
+	defaultIndexConfigRefresh = time.Hour
+	defaultMaxScrapes         = 1
+	defaultSearchPollInitial  = 200 * time.Millisecond
+	defaultSearchPollMax      = 2 * time.Second
 )
 
 func createDefaultConfig() component.Config {
@@ -36,11 +44,23 @@ func createDefaultConfig() component.Config {
 	scfg.Timeout = defaultMaxSearchWaitTime
 
 	return &Config{
-		IdxEndpoint:               httpCfg,
-		SHEndpoint:                httpCfg,
-		CMEndpoint:                httpCfg,
-		ScraperControllerSettings: scfg,
-		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
+		IdxEndpoint:                httpCfg,
+		SHEndpoint:                 httpCfg,
+		CMEndpoint:                 httpCfg,
+		ACSEndpoint:                httpCfg,
+		ScraperControllerSettings:  scfg,
+		MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
+		ClockSkewTolerance:         defaultClockSkewTolerance,
+		SilentIndexThreshold:       defaultSilentThreshold,
+		SearchMode:                 searchModeFast,
+		IndexConfigRefreshInterval: defaultIndexConfigRefresh,
+		MaxConcurrentScrapes:       defaultMaxScrapes,
+		SearchPollInitialInterval:  defaultSearchPollInitial,
+		SearchPollMaxInterval:      defaultSearchPollMax,
+		SearchVariables: map[string]string{
+			"MountPoint":               defaultMountPoint,
+			"LongRunningSearchSeconds": defaultLongRunningSearch,
+		},
 	}
 }
 
@@ -34,12 +34,21 @@ func TestDefaultConfig(t *testing.T) {
 		IdxEndpoint: cfg,
 		SHEndpoint:  cfg,
 		CMEndpoint:  cfg,
+		ACSEndpoint: cfg,
 		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 			CollectionInterval: 10 * time.Minute,
 			InitialDelay:       1 * time.Second,
 			Timeout:            60 * time.Second,
 		},
-		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
+		ClockSkewTolerance:         5 * time.Second,
+		SearchVariables:            map[string]string{"MountPoint": "/opt/splunk/var", "LongRunningSearchSeconds": "300"},
+		SilentIndexThreshold:       24 * time.Hour,
+		SearchMode:                 "fast",
+		IndexConfigRefreshInterval: time.Hour,
+		MaxConcurrentScrapes:       1,
+		SearchPollInitialInterval:  200 * time.Millisecond,
+		SearchPollMaxInterval:      2 * time.Second,
 	}
 
 	testConf := createDefaultConfig().(*Config)
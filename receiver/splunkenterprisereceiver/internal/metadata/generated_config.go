@@ -26,7 +26,22 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for splunkenterprise metrics.
 type MetricsConfig struct {
 	SplunkAggregationQueueRatio                 MetricConfig `mapstructure:"splunk.aggregation.queue.ratio"`
+	SplunkAlertsFiring                          MetricConfig `mapstructure:"splunk.alerts.firing"`
+	SplunkAlertsRealtimeCount                   MetricConfig `mapstructure:"splunk.alerts.realtime.count"`
 	SplunkBucketsSearchableStatus               MetricConfig `mapstructure:"splunk.buckets.searchable.status"`
+	SplunkBundlePushSizeBytes                   MetricConfig `mapstructure:"splunk.bundle.push.size_bytes"`
+	SplunkClusterBucketSyncLagSeconds           MetricConfig `mapstructure:"splunk.cluster.bucket_sync.lag_seconds"`
+	SplunkClusterBucketsReplicating             MetricConfig `mapstructure:"splunk.cluster.buckets.replicating"`
+	SplunkClusterFixupOldestAgeSeconds          MetricConfig `mapstructure:"splunk.cluster.fixup.oldest_age_seconds"`
+	SplunkClusterFixupPending                   MetricConfig `mapstructure:"splunk.cluster.fixup.pending"`
+	SplunkClusterGenerationID                   MetricConfig `mapstructure:"splunk.cluster.generation_id"`
+	SplunkClusterIndexBucketsQuarantined        MetricConfig `mapstructure:"splunk.cluster.index.buckets.quarantined"`
+	SplunkClusterIndexRfMetAtIngest             MetricConfig `mapstructure:"splunk.cluster.index.rf_met_at_ingest"`
+	SplunkClusterIndexingReady                  MetricConfig `mapstructure:"splunk.cluster.indexing_ready"`
+	SplunkClusterMaintenanceMode                MetricConfig `mapstructure:"splunk.cluster.maintenance_mode"`
+	SplunkClusterPeerGenerationLag              MetricConfig `mapstructure:"splunk.cluster.peer.generation_lag"`
+	SplunkClusterPeerJoins                      MetricConfig `mapstructure:"splunk.cluster.peer_joins"`
+	SplunkClusterPeerLeaves                     MetricConfig `mapstructure:"splunk.cluster.peer_leaves"`
 	SplunkDataIndexesExtendedBucketCount        MetricConfig `mapstructure:"splunk.data.indexes.extended.bucket.count"`
 	SplunkDataIndexesExtendedBucketEventCount   MetricConfig `mapstructure:"splunk.data.indexes.extended.bucket.event.count"`
 	SplunkDataIndexesExtendedBucketHotCount     MetricConfig `mapstructure:"splunk.data.indexes.extended.bucket.hot.count"`
@@ -34,25 +49,83 @@ type MetricsConfig struct {
 	SplunkDataIndexesExtendedEventCount         MetricConfig `mapstructure:"splunk.data.indexes.extended.event.count"`
 	SplunkDataIndexesExtendedRawSize            MetricConfig `mapstructure:"splunk.data.indexes.extended.raw.size"`
 	SplunkDataIndexesExtendedTotalSize          MetricConfig `mapstructure:"splunk.data.indexes.extended.total.size"`
+	SplunkDatamodelBuildsRunning                MetricConfig `mapstructure:"splunk.datamodel.builds.running"`
+	SplunkDispatchArtifactsPendingDeletion      MetricConfig `mapstructure:"splunk.dispatch.artifacts.pending_deletion"`
+	SplunkDmcInstances                          MetricConfig `mapstructure:"splunk.dmc.instances"`
+	SplunkDmcInstancesUnhealthy                 MetricConfig `mapstructure:"splunk.dmc.instances.unhealthy"`
+	SplunkHealthBlockedQueues                   MetricConfig `mapstructure:"splunk.health.blocked_queues"`
+	SplunkIndexBucketAvgSizeBytes               MetricConfig `mapstructure:"splunk.index.bucket.avg_size_bytes"`
+	SplunkIndexBucketMaxSizeBytes               MetricConfig `mapstructure:"splunk.index.bucket.max_size_bytes"`
+	SplunkIndexBucketMerges                     MetricConfig `mapstructure:"splunk.index.bucket_merges"`
+	SplunkIndexBucketRolls                      MetricConfig `mapstructure:"splunk.index.bucket_rolls"`
+	SplunkIndexBucketUtilizationRatio           MetricConfig `mapstructure:"splunk.index.bucket_utilization_ratio"`
+	SplunkIndexDaysUntilFull                    MetricConfig `mapstructure:"splunk.index.days_until_full"`
+	SplunkIndexEventsPerSecond                  MetricConfig `mapstructure:"splunk.index.events_per_second"`
+	SplunkIndexFrozenArchiveFailures            MetricConfig `mapstructure:"splunk.index.frozen.archive_failures"`
+	SplunkIndexFrozenArchiveConfigured          MetricConfig `mapstructure:"splunk.index.frozen_archive_configured"`
+	SplunkIndexHotBucketsCurrent                MetricConfig `mapstructure:"splunk.index.hot_buckets.current"`
+	SplunkIndexHotBucketsMax                    MetricConfig `mapstructure:"splunk.index.hot_buckets.max"`
+	SplunkIndexMaxBuckets                       MetricConfig `mapstructure:"splunk.index.max_buckets"`
+	SplunkIndexRetentionUtilizationRatio        MetricConfig `mapstructure:"splunk.index.retention_utilization_ratio"`
+	SplunkIndexSearchableTest                   MetricConfig `mapstructure:"splunk.index.searchable_test"`
+	SplunkIndexSharedGlobally                   MetricConfig `mapstructure:"splunk.index.shared_globally"`
+	SplunkIndexSizeColdBytes                    MetricConfig `mapstructure:"splunk.index.size.cold_bytes"`
+	SplunkIndexSizeHotBytes                     MetricConfig `mapstructure:"splunk.index.size.hot_bytes"`
+	SplunkIndexSizeWarmBytes                    MetricConfig `mapstructure:"splunk.index.size.warm_bytes"`
 	SplunkIndexerAvgRate                        MetricConfig `mapstructure:"splunk.indexer.avg.rate"`
 	SplunkIndexerCPUTime                        MetricConfig `mapstructure:"splunk.indexer.cpu.time"`
+	SplunkIndexerEventsDroppedNoIndex           MetricConfig `mapstructure:"splunk.indexer.events_dropped_no_index"`
+	SplunkIndexerEventsIndexed                  MetricConfig `mapstructure:"splunk.indexer.events_indexed"`
 	SplunkIndexerQueueRatio                     MetricConfig `mapstructure:"splunk.indexer.queue.ratio"`
 	SplunkIndexerRawWriteTime                   MetricConfig `mapstructure:"splunk.indexer.raw.write.time"`
+	SplunkIndexerSearchesServed                 MetricConfig `mapstructure:"splunk.indexer.searches_served"`
+	SplunkIndexerThrottledSeconds               MetricConfig `mapstructure:"splunk.indexer.throttled_seconds"`
 	SplunkIndexerThroughput                     MetricConfig `mapstructure:"splunk.indexer.throughput"`
 	SplunkIndexesAvgSize                        MetricConfig `mapstructure:"splunk.indexes.avg.size"`
 	SplunkIndexesAvgUsage                       MetricConfig `mapstructure:"splunk.indexes.avg.usage"`
 	SplunkIndexesBucketCount                    MetricConfig `mapstructure:"splunk.indexes.bucket.count"`
 	SplunkIndexesMedianDataAge                  MetricConfig `mapstructure:"splunk.indexes.median.data.age"`
+	SplunkIndexesSilentCount                    MetricConfig `mapstructure:"splunk.indexes.silent.count"`
 	SplunkIndexesSize                           MetricConfig `mapstructure:"splunk.indexes.size"`
+	SplunkIngestionErrors                       MetricConfig `mapstructure:"splunk.ingestion.errors"`
+	SplunkIngestionTruncations                  MetricConfig `mapstructure:"splunk.ingestion.truncations"`
 	SplunkIoAvgIops                             MetricConfig `mapstructure:"splunk.io.avg.iops"`
+	SplunkKvstoreCollectionSize                 MetricConfig `mapstructure:"splunk.kvstore.collection.size"`
+	SplunkKvstoreDiskUsedBytes                  MetricConfig `mapstructure:"splunk.kvstore.disk_used_bytes"`
+	SplunkKvstoreLookupsActive                  MetricConfig `mapstructure:"splunk.kvstore.lookups.active"`
+	SplunkKvstoreLookupsSlow                    MetricConfig `mapstructure:"splunk.kvstore.lookups.slow"`
+	SplunkKvstoreMemberUp                       MetricConfig `mapstructure:"splunk.kvstore.member.up"`
+	SplunkKvstoreOplogWindowSeconds             MetricConfig `mapstructure:"splunk.kvstore.oplog.window_seconds"`
 	SplunkLicenseIndexUsage                     MetricConfig `mapstructure:"splunk.license.index.usage"`
+	SplunkLookupCount                           MetricConfig `mapstructure:"splunk.lookup.count"`
+	SplunkLookupSizeBytes                       MetricConfig `mapstructure:"splunk.lookup.size_bytes"`
 	SplunkParseQueueRatio                       MetricConfig `mapstructure:"splunk.parse.queue.ratio"`
 	SplunkPipelineSetCount                      MetricConfig `mapstructure:"splunk.pipeline.set.count"`
+	SplunkQueueEventsPerSecond                  MetricConfig `mapstructure:"splunk.queue.events_per_second"`
+	SplunkReceiverRequestDNSTime                MetricConfig `mapstructure:"splunk.receiver.request.dns.time"`
+	SplunkReceiverRequestFirstByteTime          MetricConfig `mapstructure:"splunk.receiver.request.first_byte.time"`
+	SplunkReceiverRequestTLSHandshakeTime       MetricConfig `mapstructure:"splunk.receiver.request.tls_handshake.time"`
+	SplunkReceiverSearchEventCount              MetricConfig `mapstructure:"splunk.receiver.search.event_count"`
+	SplunkReceiverSearchFieldState              MetricConfig `mapstructure:"splunk.receiver.search.field.state"`
+	SplunkReceiverSearchResultCount             MetricConfig `mapstructure:"splunk.receiver.search.result_count"`
+	SplunkReceiverSearchRows                    MetricConfig `mapstructure:"splunk.receiver.search.rows"`
+	SplunkReceiverSearchScanCount               MetricConfig `mapstructure:"splunk.receiver.search.scan_count"`
+	SplunkReceiverSearchTimeout                 MetricConfig `mapstructure:"splunk.receiver.search.timeout"`
 	SplunkSchedulerAvgExecutionLatency          MetricConfig `mapstructure:"splunk.scheduler.avg.execution.latency"`
 	SplunkSchedulerAvgRunTime                   MetricConfig `mapstructure:"splunk.scheduler.avg.run.time"`
 	SplunkSchedulerCompletionRatio              MetricConfig `mapstructure:"splunk.scheduler.completion.ratio"`
+	SplunkSchedulerContinuedCount               MetricConfig `mapstructure:"splunk.scheduler.continued.count"`
+	SplunkSchedulerDelegatedCount               MetricConfig `mapstructure:"splunk.scheduler.delegated.count"`
+	SplunkSchedulerSkippedCount                 MetricConfig `mapstructure:"splunk.scheduler.skipped.count"`
+	SplunkSchedulerSkippedTotal                 MetricConfig `mapstructure:"splunk.scheduler.skipped.total"`
+	SplunkScrapeDurationSeconds                 MetricConfig `mapstructure:"splunk.scrape.duration_seconds"`
+	SplunkScrapeSuccess                         MetricConfig `mapstructure:"splunk.scrape.success"`
+	SplunkSearchesConcurrent                    MetricConfig `mapstructure:"splunk.searches.concurrent"`
+	SplunkSearchesLongRunningCount              MetricConfig `mapstructure:"splunk.searches.long_running.count"`
 	SplunkServerIntrospectionQueuesCurrent      MetricConfig `mapstructure:"splunk.server.introspection.queues.current"`
 	SplunkServerIntrospectionQueuesCurrentBytes MetricConfig `mapstructure:"splunk.server.introspection.queues.current.bytes"`
+	SplunkServerUptimeSeconds                   MetricConfig `mapstructure:"splunk.server.uptime_seconds"`
+	SplunkSourcetypeEvents                      MetricConfig `mapstructure:"splunk.sourcetype.events"`
 	SplunkTypingQueueRatio                      MetricConfig `mapstructure:"splunk.typing.queue.ratio"`
 }
 
@@ -61,9 +134,54 @@ func DefaultMetricsConfig() MetricsConfig {
 		SplunkAggregationQueueRatio: MetricConfig{
 			Enabled: true,
 		},
+		SplunkAlertsFiring: MetricConfig{
+			Enabled: false,
+		},
+		SplunkAlertsRealtimeCount: MetricConfig{
+			Enabled: false,
+		},
 		SplunkBucketsSearchableStatus: MetricConfig{
 			Enabled: true,
 		},
+		SplunkBundlePushSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterBucketSyncLagSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterBucketsReplicating: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterFixupOldestAgeSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterFixupPending: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterGenerationID: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterIndexBucketsQuarantined: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterIndexRfMetAtIngest: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterIndexingReady: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterMaintenanceMode: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerGenerationLag: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerJoins: MetricConfig{
+			Enabled: false,
+		},
+		SplunkClusterPeerLeaves: MetricConfig{
+			Enabled: false,
+		},
 		SplunkDataIndexesExtendedBucketCount: MetricConfig{
 			Enabled: false,
 		},
@@ -85,18 +203,99 @@ func DefaultMetricsConfig() MetricsConfig {
 		SplunkDataIndexesExtendedTotalSize: MetricConfig{
 			Enabled: false,
 		},
+		SplunkDatamodelBuildsRunning: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDispatchArtifactsPendingDeletion: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDmcInstances: MetricConfig{
+			Enabled: false,
+		},
+		SplunkDmcInstancesUnhealthy: MetricConfig{
+			Enabled: false,
+		},
+		SplunkHealthBlockedQueues: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketAvgSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketMaxSizeBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketMerges: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketRolls: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexBucketUtilizationRatio: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexDaysUntilFull: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexEventsPerSecond: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexFrozenArchiveFailures: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexFrozenArchiveConfigured: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexHotBucketsCurrent: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexHotBucketsMax: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexMaxBuckets: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexRetentionUtilizationRatio: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSearchableTest: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSharedGlobally: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSizeColdBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSizeHotBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexSizeWarmBytes: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIndexerAvgRate: MetricConfig{
 			Enabled: true,
 		},
 		SplunkIndexerCPUTime: MetricConfig{
 			Enabled: true,
 		},
+		SplunkIndexerEventsDroppedNoIndex: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexerEventsIndexed: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIndexerQueueRatio: MetricConfig{
 			Enabled: true,
 		},
 		SplunkIndexerRawWriteTime: MetricConfig{
 			Enabled: true,
 		},
+		SplunkIndexerSearchesServed: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIndexerThrottledSeconds: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIndexerThroughput: MetricConfig{
 			Enabled: false,
 		},
@@ -112,21 +311,84 @@ func DefaultMetricsConfig() MetricsConfig {
 		SplunkIndexesMedianDataAge: MetricConfig{
 			Enabled: true,
 		},
+		SplunkIndexesSilentCount: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIndexesSize: MetricConfig{
 			Enabled: true,
 		},
+		SplunkIngestionErrors: MetricConfig{
+			Enabled: false,
+		},
+		SplunkIngestionTruncations: MetricConfig{
+			Enabled: false,
+		},
 		SplunkIoAvgIops: MetricConfig{
 			Enabled: true,
 		},
+		SplunkKvstoreCollectionSize: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreDiskUsedBytes: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreLookupsActive: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreLookupsSlow: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreMemberUp: MetricConfig{
+			Enabled: false,
+		},
+		SplunkKvstoreOplogWindowSeconds: MetricConfig{
+			Enabled: false,
+		},
 		SplunkLicenseIndexUsage: MetricConfig{
 			Enabled: true,
 		},
+		SplunkLookupCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkLookupSizeBytes: MetricConfig{
+			Enabled: false,
+		},
 		SplunkParseQueueRatio: MetricConfig{
 			Enabled: true,
 		},
 		SplunkPipelineSetCount: MetricConfig{
 			Enabled: true,
 		},
+		SplunkQueueEventsPerSecond: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverRequestDNSTime: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverRequestFirstByteTime: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverRequestTLSHandshakeTime: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchEventCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchFieldState: MetricConfig{
+			Enabled: true,
+		},
+		SplunkReceiverSearchResultCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchRows: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchScanCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkReceiverSearchTimeout: MetricConfig{
+			Enabled: false,
+		},
 		SplunkSchedulerAvgExecutionLatency: MetricConfig{
 			Enabled: true,
 		},
@@ -136,12 +398,42 @@ func DefaultMetricsConfig() MetricsConfig {
 		SplunkSchedulerCompletionRatio: MetricConfig{
 			Enabled: true,
 		},
+		SplunkSchedulerContinuedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerDelegatedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerSkippedCount: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSchedulerSkippedTotal: MetricConfig{
+			Enabled: false,
+		},
+		SplunkScrapeDurationSeconds: MetricConfig{
+			Enabled: true,
+		},
+		SplunkScrapeSuccess: MetricConfig{
+			Enabled: true,
+		},
+		SplunkSearchesConcurrent: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSearchesLongRunningCount: MetricConfig{
+			Enabled: false,
+		},
 		SplunkServerIntrospectionQueuesCurrent: MetricConfig{
 			Enabled: false,
 		},
 		SplunkServerIntrospectionQueuesCurrentBytes: MetricConfig{
 			Enabled: false,
 		},
+		SplunkServerUptimeSeconds: MetricConfig{
+			Enabled: false,
+		},
+		SplunkSourcetypeEvents: MetricConfig{
+			Enabled: false,
+		},
 		SplunkTypingQueueRatio: MetricConfig{
 			Enabled: true,
 		},
@@ -27,7 +27,22 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SplunkAggregationQueueRatio:                 MetricConfig{Enabled: true},
+					SplunkAlertsFiring:                          MetricConfig{Enabled: true},
+					SplunkAlertsRealtimeCount:                   MetricConfig{Enabled: true},
 					SplunkBucketsSearchableStatus:               MetricConfig{Enabled: true},
+					SplunkBundlePushSizeBytes:                   MetricConfig{Enabled: true},
+					SplunkClusterBucketSyncLagSeconds:           MetricConfig{Enabled: true},
+					SplunkClusterBucketsReplicating:             MetricConfig{Enabled: true},
+					SplunkClusterFixupOldestAgeSeconds:          MetricConfig{Enabled: true},
+					SplunkClusterFixupPending:                   MetricConfig{Enabled: true},
+					SplunkClusterGenerationID:                   MetricConfig{Enabled: true},
+					SplunkClusterIndexBucketsQuarantined:        MetricConfig{Enabled: true},
+					SplunkClusterIndexRfMetAtIngest:             MetricConfig{Enabled: true},
+					SplunkClusterIndexingReady:                  MetricConfig{Enabled: true},
+					SplunkClusterMaintenanceMode:                MetricConfig{Enabled: true},
+					SplunkClusterPeerGenerationLag:              MetricConfig{Enabled: true},
+					SplunkClusterPeerJoins:                      MetricConfig{Enabled: true},
+					SplunkClusterPeerLeaves:                     MetricConfig{Enabled: true},
 					SplunkDataIndexesExtendedBucketCount:        MetricConfig{Enabled: true},
 					SplunkDataIndexesExtendedBucketEventCount:   MetricConfig{Enabled: true},
 					SplunkDataIndexesExtendedBucketHotCount:     MetricConfig{Enabled: true},
@@ -35,25 +50,83 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SplunkDataIndexesExtendedEventCount:         MetricConfig{Enabled: true},
 					SplunkDataIndexesExtendedRawSize:            MetricConfig{Enabled: true},
 					SplunkDataIndexesExtendedTotalSize:          MetricConfig{Enabled: true},
+					SplunkDatamodelBuildsRunning:                MetricConfig{Enabled: true},
+					SplunkDispatchArtifactsPendingDeletion:      MetricConfig{Enabled: true},
+					SplunkDmcInstances:                          MetricConfig{Enabled: true},
+					SplunkDmcInstancesUnhealthy:                 MetricConfig{Enabled: true},
+					SplunkHealthBlockedQueues:                   MetricConfig{Enabled: true},
+					SplunkIndexBucketAvgSizeBytes:               MetricConfig{Enabled: true},
+					SplunkIndexBucketMaxSizeBytes:               MetricConfig{Enabled: true},
+					SplunkIndexBucketMerges:                     MetricConfig{Enabled: true},
+					SplunkIndexBucketRolls:                      MetricConfig{Enabled: true},
+					SplunkIndexBucketUtilizationRatio:           MetricConfig{Enabled: true},
+					SplunkIndexDaysUntilFull:                    MetricConfig{Enabled: true},
+					SplunkIndexEventsPerSecond:                  MetricConfig{Enabled: true},
+					SplunkIndexFrozenArchiveFailures:            MetricConfig{Enabled: true},
+					SplunkIndexFrozenArchiveConfigured:          MetricConfig{Enabled: true},
+					SplunkIndexHotBucketsCurrent:                MetricConfig{Enabled: true},
+					SplunkIndexHotBucketsMax:                    MetricConfig{Enabled: true},
+					SplunkIndexMaxBuckets:                       MetricConfig{Enabled: true},
+					SplunkIndexRetentionUtilizationRatio:        MetricConfig{Enabled: true},
+					SplunkIndexSearchableTest:                   MetricConfig{Enabled: true},
+					SplunkIndexSharedGlobally:                   MetricConfig{Enabled: true},
+					SplunkIndexSizeColdBytes:                    MetricConfig{Enabled: true},
+					SplunkIndexSizeHotBytes:                     MetricConfig{Enabled: true},
+					SplunkIndexSizeWarmBytes:                    MetricConfig{Enabled: true},
 					SplunkIndexerAvgRate:                        MetricConfig{Enabled: true},
 					SplunkIndexerCPUTime:                        MetricConfig{Enabled: true},
+					SplunkIndexerEventsDroppedNoIndex:           MetricConfig{Enabled: true},
+					SplunkIndexerEventsIndexed:                  MetricConfig{Enabled: true},
 					SplunkIndexerQueueRatio:                     MetricConfig{Enabled: true},
 					SplunkIndexerRawWriteTime:                   MetricConfig{Enabled: true},
+					SplunkIndexerSearchesServed:                 MetricConfig{Enabled: true},
+					SplunkIndexerThrottledSeconds:               MetricConfig{Enabled: true},
 					SplunkIndexerThroughput:                     MetricConfig{Enabled: true},
 					SplunkIndexesAvgSize:                        MetricConfig{Enabled: true},
 					SplunkIndexesAvgUsage:                       MetricConfig{Enabled: true},
 					SplunkIndexesBucketCount:                    MetricConfig{Enabled: true},
 					SplunkIndexesMedianDataAge:                  MetricConfig{Enabled: true},
+					SplunkIndexesSilentCount:                    MetricConfig{Enabled: true},
 					SplunkIndexesSize:                           MetricConfig{Enabled: true},
+					SplunkIngestionErrors:                       MetricConfig{Enabled: true},
+					SplunkIngestionTruncations:                  MetricConfig{Enabled: true},
 					SplunkIoAvgIops:                             MetricConfig{Enabled: true},
+					SplunkKvstoreCollectionSize:                 MetricConfig{Enabled: true},
+					SplunkKvstoreDiskUsedBytes:                  MetricConfig{Enabled: true},
+					SplunkKvstoreLookupsActive:                  MetricConfig{Enabled: true},
+					SplunkKvstoreLookupsSlow:                    MetricConfig{Enabled: true},
+					SplunkKvstoreMemberUp:                       MetricConfig{Enabled: true},
+					SplunkKvstoreOplogWindowSeconds:             MetricConfig{Enabled: true},
 					SplunkLicenseIndexUsage:                     MetricConfig{Enabled: true},
+					SplunkLookupCount:                           MetricConfig{Enabled: true},
+					SplunkLookupSizeBytes:                       MetricConfig{Enabled: true},
 					SplunkParseQueueRatio:                       MetricConfig{Enabled: true},
 					SplunkPipelineSetCount:                      MetricConfig{Enabled: true},
+					SplunkQueueEventsPerSecond:                  MetricConfig{Enabled: true},
+					SplunkReceiverRequestDNSTime:                MetricConfig{Enabled: true},
+					SplunkReceiverRequestFirstByteTime:          MetricConfig{Enabled: true},
+					SplunkReceiverRequestTLSHandshakeTime:       MetricConfig{Enabled: true},
+					SplunkReceiverSearchEventCount:              MetricConfig{Enabled: true},
+					SplunkReceiverSearchFieldState:              MetricConfig{Enabled: true},
+					SplunkReceiverSearchResultCount:             MetricConfig{Enabled: true},
+					SplunkReceiverSearchRows:                    MetricConfig{Enabled: true},
+					SplunkReceiverSearchScanCount:               MetricConfig{Enabled: true},
+					SplunkReceiverSearchTimeout:                 MetricConfig{Enabled: true},
 					SplunkSchedulerAvgExecutionLatency:          MetricConfig{Enabled: true},
 					SplunkSchedulerAvgRunTime:                   MetricConfig{Enabled: true},
 					SplunkSchedulerCompletionRatio:              MetricConfig{Enabled: true},
+					SplunkSchedulerContinuedCount:               MetricConfig{Enabled: true},
+					SplunkSchedulerDelegatedCount:               MetricConfig{Enabled: true},
+					SplunkSchedulerSkippedCount:                 MetricConfig{Enabled: true},
+					SplunkSchedulerSkippedTotal:                 MetricConfig{Enabled: true},
+					SplunkScrapeDurationSeconds:                 MetricConfig{Enabled: true},
+					SplunkScrapeSuccess:                         MetricConfig{Enabled: true},
+					SplunkSearchesConcurrent:                    MetricConfig{Enabled: true},
+					SplunkSearchesLongRunningCount:              MetricConfig{Enabled: true},
 					SplunkServerIntrospectionQueuesCurrent:      MetricConfig{Enabled: true},
 					SplunkServerIntrospectionQueuesCurrentBytes: MetricConfig{Enabled: true},
+					SplunkServerUptimeSeconds:                   MetricConfig{Enabled: true},
+					SplunkSourcetypeEvents:                      MetricConfig{Enabled: true},
 					SplunkTypingQueueRatio:                      MetricConfig{Enabled: true},
 				},
 			},
@@ -63,7 +136,22 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SplunkAggregationQueueRatio:                 MetricConfig{Enabled: false},
+					SplunkAlertsFiring:                          MetricConfig{Enabled: false},
+					SplunkAlertsRealtimeCount:                   MetricConfig{Enabled: false},
 					SplunkBucketsSearchableStatus:               MetricConfig{Enabled: false},
+					SplunkBundlePushSizeBytes:                   MetricConfig{Enabled: false},
+					SplunkClusterBucketSyncLagSeconds:           MetricConfig{Enabled: false},
+					SplunkClusterBucketsReplicating:             MetricConfig{Enabled: false},
+					SplunkClusterFixupOldestAgeSeconds:          MetricConfig{Enabled: false},
+					SplunkClusterFixupPending:                   MetricConfig{Enabled: false},
+					SplunkClusterGenerationID:                   MetricConfig{Enabled: false},
+					SplunkClusterIndexBucketsQuarantined:        MetricConfig{Enabled: false},
+					SplunkClusterIndexRfMetAtIngest:             MetricConfig{Enabled: false},
+					SplunkClusterIndexingReady:                  MetricConfig{Enabled: false},
+					SplunkClusterMaintenanceMode:                MetricConfig{Enabled: false},
+					SplunkClusterPeerGenerationLag:              MetricConfig{Enabled: false},
+					SplunkClusterPeerJoins:                      MetricConfig{Enabled: false},
+					SplunkClusterPeerLeaves:                     MetricConfig{Enabled: false},
 					SplunkDataIndexesExtendedBucketCount:        MetricConfig{Enabled: false},
 					SplunkDataIndexesExtendedBucketEventCount:   MetricConfig{Enabled: false},
 					SplunkDataIndexesExtendedBucketHotCount:     MetricConfig{Enabled: false},
@@ -71,25 +159,83 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SplunkDataIndexesExtendedEventCount:         MetricConfig{Enabled: false},
 					SplunkDataIndexesExtendedRawSize:            MetricConfig{Enabled: false},
 					SplunkDataIndexesExtendedTotalSize:          MetricConfig{Enabled: false},
+					SplunkDatamodelBuildsRunning:                MetricConfig{Enabled: false},
+					SplunkDispatchArtifactsPendingDeletion:      MetricConfig{Enabled: false},
+					SplunkDmcInstances:                          MetricConfig{Enabled: false},
+					SplunkDmcInstancesUnhealthy:                 MetricConfig{Enabled: false},
+					SplunkHealthBlockedQueues:                   MetricConfig{Enabled: false},
+					SplunkIndexBucketAvgSizeBytes:               MetricConfig{Enabled: false},
+					SplunkIndexBucketMaxSizeBytes:               MetricConfig{Enabled: false},
+					SplunkIndexBucketMerges:                     MetricConfig{Enabled: false},
+					SplunkIndexBucketRolls:                      MetricConfig{Enabled: false},
+					SplunkIndexBucketUtilizationRatio:           MetricConfig{Enabled: false},
+					SplunkIndexDaysUntilFull:                    MetricConfig{Enabled: false},
+					SplunkIndexEventsPerSecond:                  MetricConfig{Enabled: false},
+					SplunkIndexFrozenArchiveFailures:            MetricConfig{Enabled: false},
+					SplunkIndexFrozenArchiveConfigured:          MetricConfig{Enabled: false},
+					SplunkIndexHotBucketsCurrent:                MetricConfig{Enabled: false},
+					SplunkIndexHotBucketsMax:                    MetricConfig{Enabled: false},
+					SplunkIndexMaxBuckets:                       MetricConfig{Enabled: false},
+					SplunkIndexRetentionUtilizationRatio:        MetricConfig{Enabled: false},
+					SplunkIndexSearchableTest:                   MetricConfig{Enabled: false},
+					SplunkIndexSharedGlobally:                   MetricConfig{Enabled: false},
+					SplunkIndexSizeColdBytes:                    MetricConfig{Enabled: false},
+					SplunkIndexSizeHotBytes:                     MetricConfig{Enabled: false},
+					SplunkIndexSizeWarmBytes:                    MetricConfig{Enabled: false},
 					SplunkIndexerAvgRate:                        MetricConfig{Enabled: false},
 					SplunkIndexerCPUTime:                        MetricConfig{Enabled: false},
+					SplunkIndexerEventsDroppedNoIndex:           MetricConfig{Enabled: false},
+					SplunkIndexerEventsIndexed:                  MetricConfig{Enabled: false},
 					SplunkIndexerQueueRatio:                     MetricConfig{Enabled: false},
 					SplunkIndexerRawWriteTime:                   MetricConfig{Enabled: false},
+					SplunkIndexerSearchesServed:                 MetricConfig{Enabled: false},
+					SplunkIndexerThrottledSeconds:               MetricConfig{Enabled: false},
 					SplunkIndexerThroughput:                     MetricConfig{Enabled: false},
 					SplunkIndexesAvgSize:                        MetricConfig{Enabled: false},
 					SplunkIndexesAvgUsage:                       MetricConfig{Enabled: false},
 					SplunkIndexesBucketCount:                    MetricConfig{Enabled: false},
 					SplunkIndexesMedianDataAge:                  MetricConfig{Enabled: false},
+					SplunkIndexesSilentCount:                    MetricConfig{Enabled: false},
 					SplunkIndexesSize:                           MetricConfig{Enabled: false},
+					SplunkIngestionErrors:                       MetricConfig{Enabled: false},
+					SplunkIngestionTruncations:                  MetricConfig{Enabled: false},
 					SplunkIoAvgIops:                             MetricConfig{Enabled: false},
+					SplunkKvstoreCollectionSize:                 MetricConfig{Enabled: false},
+					SplunkKvstoreDiskUsedBytes:                  MetricConfig{Enabled: false},
+					SplunkKvstoreLookupsActive:                  MetricConfig{Enabled: false},
+					SplunkKvstoreLookupsSlow:                    MetricConfig{Enabled: false},
+					SplunkKvstoreMemberUp:                       MetricConfig{Enabled: false},
+					SplunkKvstoreOplogWindowSeconds:             MetricConfig{Enabled: false},
 					SplunkLicenseIndexUsage:                     MetricConfig{Enabled: false},
+					SplunkLookupCount:                           MetricConfig{Enabled: false},
+					SplunkLookupSizeBytes:                       MetricConfig{Enabled: false},
 					SplunkParseQueueRatio:                       MetricConfig{Enabled: false},
 					SplunkPipelineSetCount:                      MetricConfig{Enabled: false},
+					SplunkQueueEventsPerSecond:                  MetricConfig{Enabled: false},
+					SplunkReceiverRequestDNSTime:                MetricConfig{Enabled: false},
+					SplunkReceiverRequestFirstByteTime:          MetricConfig{Enabled: false},
+					SplunkReceiverRequestTLSHandshakeTime:       MetricConfig{Enabled: false},
+					SplunkReceiverSearchEventCount:              MetricConfig{Enabled: false},
+					SplunkReceiverSearchFieldState:              MetricConfig{Enabled: false},
+					SplunkReceiverSearchResultCount:             MetricConfig{Enabled: false},
+					SplunkReceiverSearchRows:                    MetricConfig{Enabled: false},
+					SplunkReceiverSearchScanCount:               MetricConfig{Enabled: false},
+					SplunkReceiverSearchTimeout:                 MetricConfig{Enabled: false},
 					SplunkSchedulerAvgExecutionLatency:          MetricConfig{Enabled: false},
 					SplunkSchedulerAvgRunTime:                   MetricConfig{Enabled: false},
 					SplunkSchedulerCompletionRatio:              MetricConfig{Enabled: false},
+					SplunkSchedulerContinuedCount:               MetricConfig{Enabled: false},
+					SplunkSchedulerDelegatedCount:               MetricConfig{Enabled: false},
+					SplunkSchedulerSkippedCount:                 MetricConfig{Enabled: false},
+					SplunkSchedulerSkippedTotal:                 MetricConfig{Enabled: false},
+					SplunkScrapeDurationSeconds:                 MetricConfig{Enabled: false},
+					SplunkScrapeSuccess:                         MetricConfig{Enabled: false},
+					SplunkSearchesConcurrent:                    MetricConfig{Enabled: false},
+					SplunkSearchesLongRunningCount:              MetricConfig{Enabled: false},
 					SplunkServerIntrospectionQueuesCurrent:      MetricConfig{Enabled: false},
 					SplunkServerIntrospectionQueuesCurrentBytes: MetricConfig{Enabled: false},
+					SplunkServerUptimeSeconds:                   MetricConfig{Enabled: false},
+					SplunkSourcetypeEvents:                      MetricConfig{Enabled: false},
 					SplunkTypingQueueRatio:                      MetricConfig{Enabled: false},
 				},
 			},
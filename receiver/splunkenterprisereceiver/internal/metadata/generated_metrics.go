@@ -62,22 +62,22 @@ func newMetricSplunkAggregationQueueRatio(cfg MetricConfig) metricSplunkAggregat
 	return m
 }
 
-type metricSplunkBucketsSearchableStatus struct {
+type metricSplunkAlertsFiring struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.buckets.searchable.status metric with initial data.
-func (m *metricSplunkBucketsSearchableStatus) init() {
-	m.data.SetName("splunk.buckets.searchable.status")
-	m.data.SetDescription("Gauge tracking the number of buckets and their searchable status. *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{count}")
+// init fills splunk.alerts.firing metric with initial data.
+func (m *metricSplunkAlertsFiring) init() {
+	m.data.SetName("splunk.alerts.firing")
+	m.data.SetDescription("Gauge tracking the number of triggered alerts of each saved search which have not yet expired. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{alerts}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkBucketsSearchableStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkIndexerSearchableAttributeValue string) {
+func (m *metricSplunkAlertsFiring) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSavedsearchAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -85,19 +85,18 @@ func (m *metricSplunkBucketsSearchableStatus) recordDataPoint(start pcommon.Time
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
-	dp.Attributes().PutStr("splunk.indexer.searchable", splunkIndexerSearchableAttributeValue)
+	dp.Attributes().PutStr("splunk.savedsearch", splunkSavedsearchAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkBucketsSearchableStatus) updateCapacity() {
+func (m *metricSplunkAlertsFiring) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkBucketsSearchableStatus) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkAlertsFiring) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -105,8 +104,8 @@ func (m *metricSplunkBucketsSearchableStatus) emit(metrics pmetric.MetricSlice)
 	}
 }
 
-func newMetricSplunkBucketsSearchableStatus(cfg MetricConfig) metricSplunkBucketsSearchableStatus {
-	m := metricSplunkBucketsSearchableStatus{config: cfg}
+func newMetricSplunkAlertsFiring(cfg MetricConfig) metricSplunkAlertsFiring {
+	m := metricSplunkAlertsFiring{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -114,22 +113,22 @@ func newMetricSplunkBucketsSearchableStatus(cfg MetricConfig) metricSplunkBucket
 	return m
 }
 
-type metricSplunkDataIndexesExtendedBucketCount struct {
+type metricSplunkAlertsRealtimeCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.bucket.count metric with initial data.
-func (m *metricSplunkDataIndexesExtendedBucketCount) init() {
-	m.data.SetName("splunk.data.indexes.extended.bucket.count")
-	m.data.SetDescription("Count of buckets per index")
-	m.data.SetUnit("{buckets}")
+// init fills splunk.alerts.realtime.count metric with initial data.
+func (m *metricSplunkAlertsRealtimeCount) init() {
+	m.data.SetName("splunk.alerts.realtime.count")
+	m.data.SetDescription("Gauge tracking the number of enabled real-time alerts, by whether they trigger once per search or once per result. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{alerts}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedBucketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkAlertsRealtimeCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAlertModeAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -137,18 +136,18 @@ func (m *metricSplunkDataIndexesExtendedBucketCount) recordDataPoint(start pcomm
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.alert.mode", splunkAlertModeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedBucketCount) updateCapacity() {
+func (m *metricSplunkAlertsRealtimeCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedBucketCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkAlertsRealtimeCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -156,8 +155,8 @@ func (m *metricSplunkDataIndexesExtendedBucketCount) emit(metrics pmetric.Metric
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedBucketCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketCount {
-	m := metricSplunkDataIndexesExtendedBucketCount{config: cfg}
+func newMetricSplunkAlertsRealtimeCount(cfg MetricConfig) metricSplunkAlertsRealtimeCount {
+	m := metricSplunkAlertsRealtimeCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -165,22 +164,22 @@ func newMetricSplunkDataIndexesExtendedBucketCount(cfg MetricConfig) metricSplun
 	return m
 }
 
-type metricSplunkDataIndexesExtendedBucketEventCount struct {
+type metricSplunkBucketsSearchableStatus struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.bucket.event.count metric with initial data.
-func (m *metricSplunkDataIndexesExtendedBucketEventCount) init() {
-	m.data.SetName("splunk.data.indexes.extended.bucket.event.count")
-	m.data.SetDescription("Count of events in this bucket super-directory. *Note:** Must be pointed at specific indexer `endpoint`.")
-	m.data.SetUnit("{events}")
+// init fills splunk.buckets.searchable.status metric with initial data.
+func (m *metricSplunkBucketsSearchableStatus) init() {
+	m.data.SetName("splunk.buckets.searchable.status")
+	m.data.SetDescription("Gauge tracking the number of buckets and their searchable status. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{count}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedBucketEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
+func (m *metricSplunkBucketsSearchableStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkIndexerSearchableAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -188,19 +187,19 @@ func (m *metricSplunkDataIndexesExtendedBucketEventCount) recordDataPoint(start
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
-	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.Attributes().PutStr("splunk.indexer.searchable", splunkIndexerSearchableAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedBucketEventCount) updateCapacity() {
+func (m *metricSplunkBucketsSearchableStatus) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedBucketEventCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkBucketsSearchableStatus) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -208,8 +207,8 @@ func (m *metricSplunkDataIndexesExtendedBucketEventCount) emit(metrics pmetric.M
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedBucketEventCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketEventCount {
-	m := metricSplunkDataIndexesExtendedBucketEventCount{config: cfg}
+func newMetricSplunkBucketsSearchableStatus(cfg MetricConfig) metricSplunkBucketsSearchableStatus {
+	m := metricSplunkBucketsSearchableStatus{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -217,22 +216,22 @@ func newMetricSplunkDataIndexesExtendedBucketEventCount(cfg MetricConfig) metric
 	return m
 }
 
-type metricSplunkDataIndexesExtendedBucketHotCount struct {
+type metricSplunkBundlePushSizeBytes struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.bucket.hot.count metric with initial data.
-func (m *metricSplunkDataIndexesExtendedBucketHotCount) init() {
-	m.data.SetName("splunk.data.indexes.extended.bucket.hot.count")
-	m.data.SetDescription("(If size > 0) Number of hot buckets. *Note:** Must be pointed at specific indexer `endpoint`.")
-	m.data.SetUnit("{buckets}")
+// init fills splunk.bundle.push.size_bytes metric with initial data.
+func (m *metricSplunkBundlePushSizeBytes) init() {
+	m.data.SetName("splunk.bundle.push.size_bytes")
+	m.data.SetDescription("Gauge tracking the size of the latest knowledge bundle the search head pushed to each search peer over the last 10 minutes. Makes imbalanced or oversized bundle pushes visible. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedBucketHotCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
+func (m *metricSplunkBundlePushSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -240,19 +239,18 @@ func (m *metricSplunkDataIndexesExtendedBucketHotCount) recordDataPoint(start pc
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
-	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
+	dp.Attributes().PutStr("splunk.peer", splunkPeerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedBucketHotCount) updateCapacity() {
+func (m *metricSplunkBundlePushSizeBytes) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedBucketHotCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkBundlePushSizeBytes) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -260,8 +258,8 @@ func (m *metricSplunkDataIndexesExtendedBucketHotCount) emit(metrics pmetric.Met
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedBucketHotCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketHotCount {
-	m := metricSplunkDataIndexesExtendedBucketHotCount{config: cfg}
+func newMetricSplunkBundlePushSizeBytes(cfg MetricConfig) metricSplunkBundlePushSizeBytes {
+	m := metricSplunkBundlePushSizeBytes{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -269,22 +267,22 @@ func newMetricSplunkDataIndexesExtendedBucketHotCount(cfg MetricConfig) metricSp
 	return m
 }
 
-type metricSplunkDataIndexesExtendedBucketWarmCount struct {
+type metricSplunkClusterBucketSyncLagSeconds struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.bucket.warm.count metric with initial data.
-func (m *metricSplunkDataIndexesExtendedBucketWarmCount) init() {
-	m.data.SetName("splunk.data.indexes.extended.bucket.warm.count")
-	m.data.SetDescription("(If size > 0) Number of warm buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("{buckets}")
+// init fills splunk.cluster.bucket_sync.lag_seconds metric with initial data.
+func (m *metricSplunkClusterBucketSyncLagSeconds) init() {
+	m.data.SetName("splunk.cluster.bucket_sync.lag_seconds")
+	m.data.SetDescription("Gauge tracking how far behind the bucket synchronization of each peer of the indexer cluster is, as the seconds since the peer last completed replicating a bucket according to its splunkd log over the last 24 hours. *Note:** Search is best run against a Cluster Manager, and is run against the indexer when none is configured.")
+	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedBucketWarmCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
+func (m *metricSplunkClusterBucketSyncLagSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -292,19 +290,18 @@ func (m *metricSplunkDataIndexesExtendedBucketWarmCount) recordDataPoint(start p
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
-	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
+	dp.Attributes().PutStr("splunk.peer", splunkPeerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedBucketWarmCount) updateCapacity() {
+func (m *metricSplunkClusterBucketSyncLagSeconds) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedBucketWarmCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterBucketSyncLagSeconds) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -312,8 +309,8 @@ func (m *metricSplunkDataIndexesExtendedBucketWarmCount) emit(metrics pmetric.Me
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedBucketWarmCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketWarmCount {
-	m := metricSplunkDataIndexesExtendedBucketWarmCount{config: cfg}
+func newMetricSplunkClusterBucketSyncLagSeconds(cfg MetricConfig) metricSplunkClusterBucketSyncLagSeconds {
+	m := metricSplunkClusterBucketSyncLagSeconds{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -321,22 +318,21 @@ func newMetricSplunkDataIndexesExtendedBucketWarmCount(cfg MetricConfig) metricS
 	return m
 }
 
-type metricSplunkDataIndexesExtendedEventCount struct {
+type metricSplunkClusterBucketsReplicating struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.event.count metric with initial data.
-func (m *metricSplunkDataIndexesExtendedEventCount) init() {
-	m.data.SetName("splunk.data.indexes.extended.event.count")
-	m.data.SetDescription("Count of events for index, excluding frozen events. Approximately equal to the event_count sum of all buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("{events}")
+// init fills splunk.cluster.buckets.replicating metric with initial data.
+func (m *metricSplunkClusterBucketsReplicating) init() {
+	m.data.SetName("splunk.cluster.buckets.replicating")
+	m.data.SetDescription("Gauge tracking the number of buckets with a copy currently being replicated to a peer. Every bucket of the cluster is listed to find them, which is costly on large clusters. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("{buckets}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterBucketsReplicating) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -344,18 +340,17 @@ func (m *metricSplunkDataIndexesExtendedEventCount) recordDataPoint(start pcommo
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedEventCount) updateCapacity() {
+func (m *metricSplunkClusterBucketsReplicating) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedEventCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterBucketsReplicating) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -363,8 +358,8 @@ func (m *metricSplunkDataIndexesExtendedEventCount) emit(metrics pmetric.MetricS
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedEventCount(cfg MetricConfig) metricSplunkDataIndexesExtendedEventCount {
-	m := metricSplunkDataIndexesExtendedEventCount{config: cfg}
+func newMetricSplunkClusterBucketsReplicating(cfg MetricConfig) metricSplunkClusterBucketsReplicating {
+	m := metricSplunkClusterBucketsReplicating{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -372,22 +367,22 @@ func newMetricSplunkDataIndexesExtendedEventCount(cfg MetricConfig) metricSplunk
 	return m
 }
 
-type metricSplunkDataIndexesExtendedRawSize struct {
+type metricSplunkClusterFixupOldestAgeSeconds struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.raw.size metric with initial data.
-func (m *metricSplunkDataIndexesExtendedRawSize) init() {
-	m.data.SetName("splunk.data.indexes.extended.raw.size")
-	m.data.SetDescription("Size in bytes on disk of the <bucket>/rawdata/ directories of all buckets in this index, excluding frozen *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("By")
+// init fills splunk.cluster.fixup.oldest_age_seconds metric with initial data.
+func (m *metricSplunkClusterFixupOldestAgeSeconds) init() {
+	m.data.SetName("splunk.cluster.fixup.oldest_age_seconds")
+	m.data.SetDescription("Gauge tracking how long the oldest bucket pending fixup has been waiting per fixup level. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedRawSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterFixupOldestAgeSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkClusterFixupLevelAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -395,18 +390,18 @@ func (m *metricSplunkDataIndexesExtendedRawSize) recordDataPoint(start pcommon.T
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.fixup.level", splunkClusterFixupLevelAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedRawSize) updateCapacity() {
+func (m *metricSplunkClusterFixupOldestAgeSeconds) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedRawSize) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterFixupOldestAgeSeconds) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -414,8 +409,8 @@ func (m *metricSplunkDataIndexesExtendedRawSize) emit(metrics pmetric.MetricSlic
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedRawSize(cfg MetricConfig) metricSplunkDataIndexesExtendedRawSize {
-	m := metricSplunkDataIndexesExtendedRawSize{config: cfg}
+func newMetricSplunkClusterFixupOldestAgeSeconds(cfg MetricConfig) metricSplunkClusterFixupOldestAgeSeconds {
+	m := metricSplunkClusterFixupOldestAgeSeconds{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -423,22 +418,22 @@ func newMetricSplunkDataIndexesExtendedRawSize(cfg MetricConfig) metricSplunkDat
 	return m
 }
 
-type metricSplunkDataIndexesExtendedTotalSize struct {
+type metricSplunkClusterFixupPending struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.data.indexes.extended.total.size metric with initial data.
-func (m *metricSplunkDataIndexesExtendedTotalSize) init() {
-	m.data.SetName("splunk.data.indexes.extended.total.size")
-	m.data.SetDescription("Size in bytes on disk of this index *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("By")
+// init fills splunk.cluster.fixup.pending metric with initial data.
+func (m *metricSplunkClusterFixupPending) init() {
+	m.data.SetName("splunk.cluster.fixup.pending")
+	m.data.SetDescription("Gauge tracking the number of buckets pending fixup in the indexer cluster per fixup level. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("{buckets}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkDataIndexesExtendedTotalSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterFixupPending) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkClusterFixupLevelAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -446,18 +441,18 @@ func (m *metricSplunkDataIndexesExtendedTotalSize) recordDataPoint(start pcommon
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.cluster.fixup.level", splunkClusterFixupLevelAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkDataIndexesExtendedTotalSize) updateCapacity() {
+func (m *metricSplunkClusterFixupPending) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkDataIndexesExtendedTotalSize) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterFixupPending) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -465,8 +460,8 @@ func (m *metricSplunkDataIndexesExtendedTotalSize) emit(metrics pmetric.MetricSl
 	}
 }
 
-func newMetricSplunkDataIndexesExtendedTotalSize(cfg MetricConfig) metricSplunkDataIndexesExtendedTotalSize {
-	m := metricSplunkDataIndexesExtendedTotalSize{config: cfg}
+func newMetricSplunkClusterFixupPending(cfg MetricConfig) metricSplunkClusterFixupPending {
+	m := metricSplunkClusterFixupPending{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -474,41 +469,39 @@ func newMetricSplunkDataIndexesExtendedTotalSize(cfg MetricConfig) metricSplunkD
 	return m
 }
 
-type metricSplunkIndexerAvgRate struct {
+type metricSplunkClusterGenerationID struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.avg.rate metric with initial data.
-func (m *metricSplunkIndexerAvgRate) init() {
-	m.data.SetName("splunk.indexer.avg.rate")
-	m.data.SetDescription("Gauge tracking the average rate of indexed data. **Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("KBy")
+// init fills splunk.cluster.generation_id metric with initial data.
+func (m *metricSplunkClusterGenerationID) init() {
+	m.data.SetName("splunk.cluster.generation_id")
+	m.data.SetDescription("Gauge tracking the current generation ID of the indexer cluster, incremented by the Cluster Manager whenever the set of primary buckets changes. *Note:** Must be pointed at a Cluster Manager.")
+	m.data.SetUnit("{generation}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerAvgRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkClusterGenerationID) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerAvgRate) updateCapacity() {
+func (m *metricSplunkClusterGenerationID) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerAvgRate) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterGenerationID) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -516,8 +509,8 @@ func (m *metricSplunkIndexerAvgRate) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerAvgRate(cfg MetricConfig) metricSplunkIndexerAvgRate {
-	m := metricSplunkIndexerAvgRate{config: cfg}
+func newMetricSplunkClusterGenerationID(cfg MetricConfig) metricSplunkClusterGenerationID {
+	m := metricSplunkClusterGenerationID{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -525,41 +518,41 @@ func newMetricSplunkIndexerAvgRate(cfg MetricConfig) metricSplunkIndexerAvgRate
 	return m
 }
 
-type metricSplunkIndexerCPUTime struct {
+type metricSplunkClusterIndexBucketsQuarantined struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.cpu.time metric with initial data.
-func (m *metricSplunkIndexerCPUTime) init() {
-	m.data.SetName("splunk.indexer.cpu.time")
-	m.data.SetDescription("Gauge tracking the number of indexing process cpu seconds per instance")
-	m.data.SetUnit("{s}")
+// init fills splunk.cluster.index.buckets.quarantined metric with initial data.
+func (m *metricSplunkClusterIndexBucketsQuarantined) init() {
+	m.data.SetName("splunk.cluster.index.buckets.quarantined")
+	m.data.SetDescription("Gauge tracking the number of buckets of each index reported corrupt, for example because of checksum errors, which are a risk to the integrity of its data. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{buckets}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkClusterIndexBucketsQuarantined) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerCPUTime) updateCapacity() {
+func (m *metricSplunkClusterIndexBucketsQuarantined) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerCPUTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterIndexBucketsQuarantined) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -567,8 +560,8 @@ func (m *metricSplunkIndexerCPUTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerCPUTime(cfg MetricConfig) metricSplunkIndexerCPUTime {
-	m := metricSplunkIndexerCPUTime{config: cfg}
+func newMetricSplunkClusterIndexBucketsQuarantined(cfg MetricConfig) metricSplunkClusterIndexBucketsQuarantined {
+	m := metricSplunkClusterIndexBucketsQuarantined{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -576,41 +569,41 @@ func newMetricSplunkIndexerCPUTime(cfg MetricConfig) metricSplunkIndexerCPUTime
 	return m
 }
 
-type metricSplunkIndexerQueueRatio struct {
+type metricSplunkClusterIndexRfMetAtIngest struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.queue.ratio metric with initial data.
-func (m *metricSplunkIndexerQueueRatio) init() {
-	m.data.SetName("splunk.indexer.queue.ratio")
-	m.data.SetDescription("Gauge tracking the average indexer index queue ration (%). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{%}")
+// init fills splunk.cluster.index.rf_met_at_ingest metric with initial data.
+func (m *metricSplunkClusterIndexRfMetAtIngest) init() {
+	m.data.SetName("splunk.cluster.index.rf_met_at_ingest")
+	m.data.SetDescription("Gauge reporting whether the replication factor of an index was met as its data was ingested, 1 when it was and 0 when its buckets were written with fewer copies than the replication factor. Unlike the replicated copies ratio, which reflects the cluster once it has caught up, this catches indexes falling behind on replication at ingest time. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("{status}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerQueueRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkClusterIndexRfMetAtIngest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerQueueRatio) updateCapacity() {
+func (m *metricSplunkClusterIndexRfMetAtIngest) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerQueueRatio) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterIndexRfMetAtIngest) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -618,8 +611,8 @@ func (m *metricSplunkIndexerQueueRatio) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerQueueRatio(cfg MetricConfig) metricSplunkIndexerQueueRatio {
-	m := metricSplunkIndexerQueueRatio{config: cfg}
+func newMetricSplunkClusterIndexRfMetAtIngest(cfg MetricConfig) metricSplunkClusterIndexRfMetAtIngest {
+	m := metricSplunkClusterIndexRfMetAtIngest{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -627,41 +620,39 @@ func newMetricSplunkIndexerQueueRatio(cfg MetricConfig) metricSplunkIndexerQueue
 	return m
 }
 
-type metricSplunkIndexerRawWriteTime struct {
+type metricSplunkClusterIndexingReady struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.raw.write.time metric with initial data.
-func (m *metricSplunkIndexerRawWriteTime) init() {
-	m.data.SetName("splunk.indexer.raw.write.time")
-	m.data.SetDescription("Gauge tracking the number of raw write seconds per instance")
-	m.data.SetUnit("{s}")
+// init fills splunk.cluster.indexing_ready metric with initial data.
+func (m *metricSplunkClusterIndexingReady) init() {
+	m.data.SetName("splunk.cluster.indexing_ready")
+	m.data.SetDescription("Gauge reporting whether the indexer cluster has enough searchable copies to accept data, 1 when ready and 0 otherwise. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("{status}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerRawWriteTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkClusterIndexingReady) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerRawWriteTime) updateCapacity() {
+func (m *metricSplunkClusterIndexingReady) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerRawWriteTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterIndexingReady) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -669,8 +660,8 @@ func (m *metricSplunkIndexerRawWriteTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerRawWriteTime(cfg MetricConfig) metricSplunkIndexerRawWriteTime {
-	m := metricSplunkIndexerRawWriteTime{config: cfg}
+func newMetricSplunkClusterIndexingReady(cfg MetricConfig) metricSplunkClusterIndexingReady {
+	m := metricSplunkClusterIndexingReady{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -678,41 +669,39 @@ func newMetricSplunkIndexerRawWriteTime(cfg MetricConfig) metricSplunkIndexerRaw
 	return m
 }
 
-type metricSplunkIndexerThroughput struct {
+type metricSplunkClusterMaintenanceMode struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexer.throughput metric with initial data.
-func (m *metricSplunkIndexerThroughput) init() {
-	m.data.SetName("splunk.indexer.throughput")
-	m.data.SetDescription("Gauge tracking average bytes per second throughput of indexer. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("By/s")
+// init fills splunk.cluster.maintenance_mode metric with initial data.
+func (m *metricSplunkClusterMaintenanceMode) init() {
+	m.data.SetName("splunk.cluster.maintenance_mode")
+	m.data.SetDescription("Gauge reporting whether the indexer cluster is in maintenance mode, 1 when enabled and 0 otherwise. *Note:** Must be pointed at a cluster master `endpoint`.")
+	m.data.SetUnit("{status}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexerThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
+func (m *metricSplunkClusterMaintenanceMode) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.indexer.status", splunkIndexerStatusAttributeValue)
+	dp.SetIntValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexerThroughput) updateCapacity() {
+func (m *metricSplunkClusterMaintenanceMode) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterMaintenanceMode) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -720,8 +709,8 @@ func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThroughput {
-	m := metricSplunkIndexerThroughput{config: cfg}
+func newMetricSplunkClusterMaintenanceMode(cfg MetricConfig) metricSplunkClusterMaintenanceMode {
+	m := metricSplunkClusterMaintenanceMode{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -729,41 +718,41 @@ func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThrou
 	return m
 }
 
-type metricSplunkIndexesAvgSize struct {
+type metricSplunkClusterPeerGenerationLag struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexes.avg.size metric with initial data.
-func (m *metricSplunkIndexesAvgSize) init() {
-	m.data.SetName("splunk.indexes.avg.size")
-	m.data.SetDescription("Gauge tracking the indexes and their average size (gb). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("Gb")
+// init fills splunk.cluster.peer.generation_lag metric with initial data.
+func (m *metricSplunkClusterPeerGenerationLag) init() {
+	m.data.SetName("splunk.cluster.peer.generation_lag")
+	m.data.SetDescription("Gauge tracking how many generations each peer of the indexer cluster is behind the current generation of the Cluster Manager. Peers lagging behind indicate replication problems. *Note:** Must be pointed at a Cluster Manager.")
+	m.data.SetUnit("{generations}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexesAvgSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterPeerGenerationLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.peer", splunkPeerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexesAvgSize) updateCapacity() {
+func (m *metricSplunkClusterPeerGenerationLag) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexesAvgSize) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterPeerGenerationLag) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -771,8 +760,8 @@ func (m *metricSplunkIndexesAvgSize) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexesAvgSize(cfg MetricConfig) metricSplunkIndexesAvgSize {
-	m := metricSplunkIndexesAvgSize{config: cfg}
+func newMetricSplunkClusterPeerGenerationLag(cfg MetricConfig) metricSplunkClusterPeerGenerationLag {
+	m := metricSplunkClusterPeerGenerationLag{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -780,41 +769,41 @@ func newMetricSplunkIndexesAvgSize(cfg MetricConfig) metricSplunkIndexesAvgSize
 	return m
 }
 
-type metricSplunkIndexesAvgUsage struct {
+type metricSplunkClusterPeerJoins struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexes.avg.usage metric with initial data.
-func (m *metricSplunkIndexesAvgUsage) init() {
-	m.data.SetName("splunk.indexes.avg.usage")
-	m.data.SetDescription("Gauge tracking the indexes and their average usage (%). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{%}")
+// init fills splunk.cluster.peer_joins metric with initial data.
+func (m *metricSplunkClusterPeerJoins) init() {
+	m.data.SetName("splunk.cluster.peer_joins")
+	m.data.SetDescription("Gauge tracking the number of times a peer joined the indexer cluster over the last 10 minutes, counted from the `event=addPeer` messages the cluster master logs. A peer frequently joining and leaving is flapping. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{joins}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexesAvgUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterPeerJoins) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.peer", splunkPeerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexesAvgUsage) updateCapacity() {
+func (m *metricSplunkClusterPeerJoins) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexesAvgUsage) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterPeerJoins) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -822,8 +811,8 @@ func (m *metricSplunkIndexesAvgUsage) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexesAvgUsage(cfg MetricConfig) metricSplunkIndexesAvgUsage {
-	m := metricSplunkIndexesAvgUsage{config: cfg}
+func newMetricSplunkClusterPeerJoins(cfg MetricConfig) metricSplunkClusterPeerJoins {
+	m := metricSplunkClusterPeerJoins{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -831,22 +820,22 @@ func newMetricSplunkIndexesAvgUsage(cfg MetricConfig) metricSplunkIndexesAvgUsag
 	return m
 }
 
-type metricSplunkIndexesBucketCount struct {
+type metricSplunkClusterPeerLeaves struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexes.bucket.count metric with initial data.
-func (m *metricSplunkIndexesBucketCount) init() {
-	m.data.SetName("splunk.indexes.bucket.count")
-	m.data.SetDescription("Gauge tracking the indexes and their bucket counts. *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{count}")
+// init fills splunk.cluster.peer_leaves metric with initial data.
+func (m *metricSplunkClusterPeerLeaves) init() {
+	m.data.SetName("splunk.cluster.peer_leaves")
+	m.data.SetDescription("Gauge tracking the number of times a peer left the indexer cluster over the last 10 minutes, counted from the a transition to `Down` messages the cluster master logs. A peer frequently joining and leaving is flapping. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{leaves}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexesBucketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkClusterPeerLeaves) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -854,18 +843,18 @@ func (m *metricSplunkIndexesBucketCount) recordDataPoint(start pcommon.Timestamp
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.peer", splunkPeerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexesBucketCount) updateCapacity() {
+func (m *metricSplunkClusterPeerLeaves) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexesBucketCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkClusterPeerLeaves) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -873,8 +862,8 @@ func (m *metricSplunkIndexesBucketCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexesBucketCount(cfg MetricConfig) metricSplunkIndexesBucketCount {
-	m := metricSplunkIndexesBucketCount{config: cfg}
+func newMetricSplunkClusterPeerLeaves(cfg MetricConfig) metricSplunkClusterPeerLeaves {
+	m := metricSplunkClusterPeerLeaves{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -882,22 +871,22 @@ func newMetricSplunkIndexesBucketCount(cfg MetricConfig) metricSplunkIndexesBuck
 	return m
 }
 
-type metricSplunkIndexesMedianDataAge struct {
+type metricSplunkDataIndexesExtendedBucketCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexes.median.data.age metric with initial data.
-func (m *metricSplunkIndexesMedianDataAge) init() {
-	m.data.SetName("splunk.indexes.median.data.age")
-	m.data.SetDescription("Gauge tracking the indexes and their median data age (days). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{days}")
+// init fills splunk.data.indexes.extended.bucket.count metric with initial data.
+func (m *metricSplunkDataIndexesExtendedBucketCount) init() {
+	m.data.SetName("splunk.data.indexes.extended.bucket.count")
+	m.data.SetDescription("Count of buckets per index")
+	m.data.SetUnit("{buckets}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexesMedianDataAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkDataIndexesExtendedBucketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -909,14 +898,14 @@ func (m *metricSplunkIndexesMedianDataAge) recordDataPoint(start pcommon.Timesta
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexesMedianDataAge) updateCapacity() {
+func (m *metricSplunkDataIndexesExtendedBucketCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexesMedianDataAge) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkDataIndexesExtendedBucketCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -924,8 +913,8 @@ func (m *metricSplunkIndexesMedianDataAge) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexesMedianDataAge(cfg MetricConfig) metricSplunkIndexesMedianDataAge {
-	m := metricSplunkIndexesMedianDataAge{config: cfg}
+func newMetricSplunkDataIndexesExtendedBucketCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketCount {
+	m := metricSplunkDataIndexesExtendedBucketCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -933,41 +922,42 @@ func newMetricSplunkIndexesMedianDataAge(cfg MetricConfig) metricSplunkIndexesMe
 	return m
 }
 
-type metricSplunkIndexesSize struct {
+type metricSplunkDataIndexesExtendedBucketEventCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.indexes.size metric with initial data.
-func (m *metricSplunkIndexesSize) init() {
-	m.data.SetName("splunk.indexes.size")
-	m.data.SetDescription("Gauge tracking the indexes and their total size (gb). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("Gb")
+// init fills splunk.data.indexes.extended.bucket.event.count metric with initial data.
+func (m *metricSplunkDataIndexesExtendedBucketEventCount) init() {
+	m.data.SetName("splunk.data.indexes.extended.bucket.event.count")
+	m.data.SetDescription("Count of events in this bucket super-directory. *Note:** Must be pointed at specific indexer `endpoint`.")
+	m.data.SetUnit("{events}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIndexesSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkDataIndexesExtendedBucketEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
+	dp.SetIntValue(val)
 	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIndexesSize) updateCapacity() {
+func (m *metricSplunkDataIndexesExtendedBucketEventCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIndexesSize) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkDataIndexesExtendedBucketEventCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -975,8 +965,8 @@ func (m *metricSplunkIndexesSize) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIndexesSize(cfg MetricConfig) metricSplunkIndexesSize {
-	m := metricSplunkIndexesSize{config: cfg}
+func newMetricSplunkDataIndexesExtendedBucketEventCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketEventCount {
+	m := metricSplunkDataIndexesExtendedBucketEventCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -984,22 +974,22 @@ func newMetricSplunkIndexesSize(cfg MetricConfig) metricSplunkIndexesSize {
 	return m
 }
 
-type metricSplunkIoAvgIops struct {
+type metricSplunkDataIndexesExtendedBucketHotCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.io.avg.iops metric with initial data.
-func (m *metricSplunkIoAvgIops) init() {
-	m.data.SetName("splunk.io.avg.iops")
-	m.data.SetDescription("Gauge tracking the average IOPs used per instance")
-	m.data.SetUnit("{iops}")
+// init fills splunk.data.indexes.extended.bucket.hot.count metric with initial data.
+func (m *metricSplunkDataIndexesExtendedBucketHotCount) init() {
+	m.data.SetName("splunk.data.indexes.extended.bucket.hot.count")
+	m.data.SetDescription("(If size > 0) Number of hot buckets. *Note:** Must be pointed at specific indexer `endpoint`.")
+	m.data.SetUnit("{buckets}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkIoAvgIops) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+func (m *metricSplunkDataIndexesExtendedBucketHotCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1007,18 +997,19 @@ func (m *metricSplunkIoAvgIops) recordDataPoint(start pcommon.Timestamp, ts pcom
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkIoAvgIops) updateCapacity() {
+func (m *metricSplunkDataIndexesExtendedBucketHotCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkIoAvgIops) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkDataIndexesExtendedBucketHotCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1026,8 +1017,8 @@ func (m *metricSplunkIoAvgIops) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkIoAvgIops(cfg MetricConfig) metricSplunkIoAvgIops {
-	m := metricSplunkIoAvgIops{config: cfg}
+func newMetricSplunkDataIndexesExtendedBucketHotCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketHotCount {
+	m := metricSplunkDataIndexesExtendedBucketHotCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1035,22 +1026,125 @@ func newMetricSplunkIoAvgIops(cfg MetricConfig) metricSplunkIoAvgIops {
 	return m
 }
 
-type metricSplunkLicenseIndexUsage struct {
+type metricSplunkDataIndexesExtendedBucketWarmCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.license.index.usage metric with initial data.
-func (m *metricSplunkLicenseIndexUsage) init() {
-	m.data.SetName("splunk.license.index.usage")
-	m.data.SetDescription("Gauge tracking the indexed license usage per index")
+// init fills splunk.data.indexes.extended.bucket.warm.count metric with initial data.
+func (m *metricSplunkDataIndexesExtendedBucketWarmCount) init() {
+	m.data.SetName("splunk.data.indexes.extended.bucket.warm.count")
+	m.data.SetDescription("(If size > 0) Number of warm buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDataIndexesExtendedBucketWarmCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string, splunkBucketDirAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.bucket.dir", splunkBucketDirAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDataIndexesExtendedBucketWarmCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDataIndexesExtendedBucketWarmCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDataIndexesExtendedBucketWarmCount(cfg MetricConfig) metricSplunkDataIndexesExtendedBucketWarmCount {
+	m := metricSplunkDataIndexesExtendedBucketWarmCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDataIndexesExtendedEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.data.indexes.extended.event.count metric with initial data.
+func (m *metricSplunkDataIndexesExtendedEventCount) init() {
+	m.data.SetName("splunk.data.indexes.extended.event.count")
+	m.data.SetDescription("Count of events for index, excluding frozen events. Approximately equal to the event_count sum of all buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDataIndexesExtendedEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDataIndexesExtendedEventCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDataIndexesExtendedEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDataIndexesExtendedEventCount(cfg MetricConfig) metricSplunkDataIndexesExtendedEventCount {
+	m := metricSplunkDataIndexesExtendedEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDataIndexesExtendedRawSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.data.indexes.extended.raw.size metric with initial data.
+func (m *metricSplunkDataIndexesExtendedRawSize) init() {
+	m.data.SetName("splunk.data.indexes.extended.raw.size")
+	m.data.SetDescription("Size in bytes on disk of the <bucket>/rawdata/ directories of all buckets in this index, excluding frozen *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
 	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+func (m *metricSplunkDataIndexesExtendedRawSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1062,14 +1156,14 @@ func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkLicenseIndexUsage) updateCapacity() {
+func (m *metricSplunkDataIndexesExtendedRawSize) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkDataIndexesExtendedRawSize) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1077,8 +1171,8 @@ func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndexUsage {
-	m := metricSplunkLicenseIndexUsage{config: cfg}
+func newMetricSplunkDataIndexesExtendedRawSize(cfg MetricConfig) metricSplunkDataIndexesExtendedRawSize {
+	m := metricSplunkDataIndexesExtendedRawSize{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1086,41 +1180,3658 @@ func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndex
 	return m
 }
 
-type metricSplunkParseQueueRatio struct {
+type metricSplunkDataIndexesExtendedTotalSize struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.parse.queue.ratio metric with initial data.
-func (m *metricSplunkParseQueueRatio) init() {
-	m.data.SetName("splunk.parse.queue.ratio")
-	m.data.SetDescription("Gauge tracking the average indexer parser queue ration (%). *Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("{%}")
+// init fills splunk.data.indexes.extended.total.size metric with initial data.
+func (m *metricSplunkDataIndexesExtendedTotalSize) init() {
+	m.data.SetName("splunk.data.indexes.extended.total.size")
+	m.data.SetDescription("Size in bytes on disk of this index *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkParseQueueRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkDataIndexesExtendedTotalSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDataIndexesExtendedTotalSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDataIndexesExtendedTotalSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDataIndexesExtendedTotalSize(cfg MetricConfig) metricSplunkDataIndexesExtendedTotalSize {
+	m := metricSplunkDataIndexesExtendedTotalSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDatamodelBuildsRunning struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.datamodel.builds.running metric with initial data.
+func (m *metricSplunkDatamodelBuildsRunning) init() {
+	m.data.SetName("splunk.datamodel.builds.running")
+	m.data.SetDescription("Gauge tracking the number of data model acceleration summaries currently being built, showing how much of the scheduler capacity acceleration is using. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{builds}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDatamodelBuildsRunning) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDatamodelBuildsRunning) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDatamodelBuildsRunning) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDatamodelBuildsRunning(cfg MetricConfig) metricSplunkDatamodelBuildsRunning {
+	m := metricSplunkDatamodelBuildsRunning{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDispatchArtifactsPendingDeletion struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.dispatch.artifacts.pending_deletion metric with initial data.
+func (m *metricSplunkDispatchArtifactsPendingDeletion) init() {
+	m.data.SetName("splunk.dispatch.artifacts.pending_deletion")
+	m.data.SetDescription("Gauge tracking the number of search artifacts in the dispatch directory whose time to live expired and which wait on the dispatch reaper to be deleted. A growing count means cleanup is falling behind. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{artifacts}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDispatchArtifactsPendingDeletion) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDispatchArtifactsPendingDeletion) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDispatchArtifactsPendingDeletion) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDispatchArtifactsPendingDeletion(cfg MetricConfig) metricSplunkDispatchArtifactsPendingDeletion {
+	m := metricSplunkDispatchArtifactsPendingDeletion{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDmcInstances struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.dmc.instances metric with initial data.
+func (m *metricSplunkDmcInstances) init() {
+	m.data.SetName("splunk.dmc.instances")
+	m.data.SetDescription("Gauge tracking the number of instances of the deployment known to the Monitoring Console per server role and health. Instances with several roles are counted once per role. *Note:** Must be pointed at the search head running the Monitoring Console.")
+	m.data.SetUnit("{instances}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkDmcInstances) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkServerRoleAttributeValue string, splunkHealthStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.server.role", splunkServerRoleAttributeValue)
+	dp.Attributes().PutStr("splunk.health.status", splunkHealthStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDmcInstances) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDmcInstances) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDmcInstances(cfg MetricConfig) metricSplunkDmcInstances {
+	m := metricSplunkDmcInstances{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkDmcInstancesUnhealthy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.dmc.instances.unhealthy metric with initial data.
+func (m *metricSplunkDmcInstancesUnhealthy) init() {
+	m.data.SetName("splunk.dmc.instances.unhealthy")
+	m.data.SetDescription("Gauge tracking the number of instances of the deployment the Monitoring Console reports as down or unhealthy. *Note:** Must be pointed at the search head running the Monitoring Console.")
+	m.data.SetUnit("{instances}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkDmcInstancesUnhealthy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkDmcInstancesUnhealthy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkDmcInstancesUnhealthy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkDmcInstancesUnhealthy(cfg MetricConfig) metricSplunkDmcInstancesUnhealthy {
+	m := metricSplunkDmcInstancesUnhealthy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkHealthBlockedQueues struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.health.blocked_queues metric with initial data.
+func (m *metricSplunkHealthBlockedQueues) init() {
+	m.data.SetName("splunk.health.blocked_queues")
+	m.data.SetDescription("Gauge tracking the blocked queues indicator of the splunkd health report, as 0 for green, 1 for yellow and 2 for red. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkHealthBlockedQueues) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkHealthBlockedQueues) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkHealthBlockedQueues) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkHealthBlockedQueues(cfg MetricConfig) metricSplunkHealthBlockedQueues {
+	m := metricSplunkHealthBlockedQueues{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketAvgSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket.avg_size_bytes metric with initial data.
+func (m *metricSplunkIndexBucketAvgSizeBytes) init() {
+	m.data.SetName("splunk.index.bucket.avg_size_bytes")
+	m.data.SetDescription("Gauge tracking the average on-disk size of the buckets of each index, computed with dbinspect. Useful alongside the maximum bucket size for tuning maxDataSize.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketAvgSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketAvgSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketAvgSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketAvgSizeBytes(cfg MetricConfig) metricSplunkIndexBucketAvgSizeBytes {
+	m := metricSplunkIndexBucketAvgSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketMaxSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket.max_size_bytes metric with initial data.
+func (m *metricSplunkIndexBucketMaxSizeBytes) init() {
+	m.data.SetName("splunk.index.bucket.max_size_bytes")
+	m.data.SetDescription("Gauge tracking the on-disk size of the largest bucket of each index, computed with dbinspect.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketMaxSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketMaxSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketMaxSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketMaxSizeBytes(cfg MetricConfig) metricSplunkIndexBucketMaxSizeBytes {
+	m := metricSplunkIndexBucketMaxSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketMerges struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket_merges metric with initial data.
+func (m *metricSplunkIndexBucketMerges) init() {
+	m.data.SetName("splunk.index.bucket_merges")
+	m.data.SetDescription("Gauge tracking the number of bucket merges per index over the last 10 minutes, counted from the BucketMerger messages in splunkd.log.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketMerges) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketMerges) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketMerges) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketMerges(cfg MetricConfig) metricSplunkIndexBucketMerges {
+	m := metricSplunkIndexBucketMerges{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketRolls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket_rolls metric with initial data.
+func (m *metricSplunkIndexBucketRolls) init() {
+	m.data.SetName("splunk.index.bucket_rolls")
+	m.data.SetDescription("Gauge tracking the number of hot buckets rolled to warm per index over the last 10 minutes, counted from the HotBucketRoller messages in splunkd.log. A high count can indicate excessive small bucket creation.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketRolls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketRolls) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketRolls) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketRolls(cfg MetricConfig) metricSplunkIndexBucketRolls {
+	m := metricSplunkIndexBucketRolls{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexBucketUtilizationRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.bucket_utilization_ratio metric with initial data.
+func (m *metricSplunkIndexBucketUtilizationRatio) init() {
+	m.data.SetName("splunk.index.bucket_utilization_ratio")
+	m.data.SetDescription("Gauge tracking the ratio of the warm buckets of an index to its maximum number of warm buckets. Nearing 1 warns that buckets are about to roll to cold because of the bucket count limit. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexBucketUtilizationRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexBucketUtilizationRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexBucketUtilizationRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexBucketUtilizationRatio(cfg MetricConfig) metricSplunkIndexBucketUtilizationRatio {
+	m := metricSplunkIndexBucketUtilizationRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexDaysUntilFull struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.days_until_full metric with initial data.
+func (m *metricSplunkIndexDaysUntilFull) init() {
+	m.data.SetName("splunk.index.days_until_full")
+	m.data.SetDescription("Gauge tracking the projected number of days until an index reaches its maxTotalDataSizeMB, based on the growth between two scrapes. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{days}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexDaysUntilFull) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexDaysUntilFull) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexDaysUntilFull) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexDaysUntilFull(cfg MetricConfig) metricSplunkIndexDaysUntilFull {
+	m := metricSplunkIndexDaysUntilFull{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexEventsPerSecond struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.events_per_second metric with initial data.
+func (m *metricSplunkIndexEventsPerSecond) init() {
+	m.data.SetName("splunk.index.events_per_second")
+	m.data.SetDescription("Gauge tracking the average number of events per second each indexer indexed into each index over the last 10 minutes, from the per_index_thruput metrics of metrics.log. Use it to pinpoint the index and host combinations taking the most events. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{events}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexEventsPerSecond) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexEventsPerSecond) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexEventsPerSecond) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexEventsPerSecond(cfg MetricConfig) metricSplunkIndexEventsPerSecond {
+	m := metricSplunkIndexEventsPerSecond{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexFrozenArchiveFailures struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.frozen.archive_failures metric with initial data.
+func (m *metricSplunkIndexFrozenArchiveFailures) init() {
+	m.data.SetName("splunk.index.frozen.archive_failures")
+	m.data.SetDescription("Gauge tracking the number of errors logged over the last 10 minutes while archiving the buckets of each index as they roll to frozen. A failing `coldToFrozenScript` otherwise loses data silently. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{errors}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexFrozenArchiveFailures) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexFrozenArchiveFailures) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexFrozenArchiveFailures) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexFrozenArchiveFailures(cfg MetricConfig) metricSplunkIndexFrozenArchiveFailures {
+	m := metricSplunkIndexFrozenArchiveFailures{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexFrozenArchiveConfigured struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.frozen_archive_configured metric with initial data.
+func (m *metricSplunkIndexFrozenArchiveConfigured) init() {
+	m.data.SetName("splunk.index.frozen_archive_configured")
+	m.data.SetDescription("Gauge reporting whether an index archives buckets as they roll to frozen, 1 when a `coldToFrozenDir` or `coldToFrozenScript` is configured and 0 when frozen buckets are deleted.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexFrozenArchiveConfigured) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexFrozenArchiveConfigured) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexFrozenArchiveConfigured) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexFrozenArchiveConfigured(cfg MetricConfig) metricSplunkIndexFrozenArchiveConfigured {
+	m := metricSplunkIndexFrozenArchiveConfigured{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexHotBucketsCurrent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.hot_buckets.current metric with initial data.
+func (m *metricSplunkIndexHotBucketsCurrent) init() {
+	m.data.SetName("splunk.index.hot_buckets.current")
+	m.data.SetDescription("Gauge tracking the number of hot buckets open for an index. Once an index reaches its `maxHotBuckets` every new bucket rolls an existing one to warm, and indexing into it stalls when buckets cannot roll fast enough. *Note:** Must be pointed at an indexer `endpoint`.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexHotBucketsCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexHotBucketsCurrent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexHotBucketsCurrent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexHotBucketsCurrent(cfg MetricConfig) metricSplunkIndexHotBucketsCurrent {
+	m := metricSplunkIndexHotBucketsCurrent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexHotBucketsMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.hot_buckets.max metric with initial data.
+func (m *metricSplunkIndexHotBucketsMax) init() {
+	m.data.SetName("splunk.index.hot_buckets.max")
+	m.data.SetDescription("Gauge tracking the maximum number of hot buckets an index may have open at once, its `maxHotBuckets` setting, with `auto` and `auto_high_volume` reported as the 3 and 10 buckets they stand for. Compare with `splunk.index.hot_buckets.current` to alert before an index runs out of hot buckets. *Note:** Must be pointed at an indexer `endpoint`.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexHotBucketsMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexHotBucketsMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexHotBucketsMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexHotBucketsMax(cfg MetricConfig) metricSplunkIndexHotBucketsMax {
+	m := metricSplunkIndexHotBucketsMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexMaxBuckets struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.max_buckets metric with initial data.
+func (m *metricSplunkIndexMaxBuckets) init() {
+	m.data.SetName("splunk.index.max_buckets")
+	m.data.SetDescription("Gauge tracking the maximum number of warm buckets (maxWarmDBCount) an index may hold before its oldest warm buckets roll to cold. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{buckets}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexMaxBuckets) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexMaxBuckets) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexMaxBuckets) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexMaxBuckets(cfg MetricConfig) metricSplunkIndexMaxBuckets {
+	m := metricSplunkIndexMaxBuckets{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexRetentionUtilizationRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.retention_utilization_ratio metric with initial data.
+func (m *metricSplunkIndexRetentionUtilizationRatio) init() {
+	m.data.SetName("splunk.index.retention_utilization_ratio")
+	m.data.SetDescription("Gauge tracking the median age of the data of each index as a fraction of its frozenTimePeriodInSecs. Data is rolled to frozen as it reaches a ratio of 1. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexRetentionUtilizationRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexRetentionUtilizationRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexRetentionUtilizationRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexRetentionUtilizationRatio(cfg MetricConfig) metricSplunkIndexRetentionUtilizationRatio {
+	m := metricSplunkIndexRetentionUtilizationRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSearchableTest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.searchable_test metric with initial data.
+func (m *metricSplunkIndexSearchableTest) init() {
+	m.data.SetName("splunk.index.searchable_test")
+	m.data.SetDescription("Gauge reporting whether a test search against the index returned any events, 1 when it did and 0 otherwise. Catches permission and configuration issues that bucket metrics miss. Only reported for the indexes listed in `searchable_test_indexes`. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSearchableTest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSearchableTest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSearchableTest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSearchableTest(cfg MetricConfig) metricSplunkIndexSearchableTest {
+	m := metricSplunkIndexSearchableTest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSharedGlobally struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.shared_globally metric with initial data.
+func (m *metricSplunkIndexSharedGlobally) init() {
+	m.data.SetName("splunk.index.shared_globally")
+	m.data.SetDescription("Gauge reporting whether an index is shared globally, making it visible from every app, 1 when its sharing is `global` and 0 otherwise.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSharedGlobally) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSharedGlobally) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSharedGlobally) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSharedGlobally(cfg MetricConfig) metricSplunkIndexSharedGlobally {
+	m := metricSplunkIndexSharedGlobally{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSizeColdBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.size.cold_bytes metric with initial data.
+func (m *metricSplunkIndexSizeColdBytes) init() {
+	m.data.SetName("splunk.index.size.cold_bytes")
+	m.data.SetDescription("Gauge tracking the size in bytes on disk of its cold buckets, the buckets moved to the cold path, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSizeColdBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSizeColdBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSizeColdBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSizeColdBytes(cfg MetricConfig) metricSplunkIndexSizeColdBytes {
+	m := metricSplunkIndexSizeColdBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSizeHotBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.size.hot_bytes metric with initial data.
+func (m *metricSplunkIndexSizeHotBytes) init() {
+	m.data.SetName("splunk.index.size.hot_bytes")
+	m.data.SetDescription("Gauge tracking the size in bytes on disk of its hot buckets, the buckets open for writing, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSizeHotBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSizeHotBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSizeHotBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSizeHotBytes(cfg MetricConfig) metricSplunkIndexSizeHotBytes {
+	m := metricSplunkIndexSizeHotBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexSizeWarmBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.index.size.warm_bytes metric with initial data.
+func (m *metricSplunkIndexSizeWarmBytes) init() {
+	m.data.SetName("splunk.index.size.warm_bytes")
+	m.data.SetDescription("Gauge tracking the size in bytes on disk of its warm buckets, the rolled buckets remaining on the home path, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexSizeWarmBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexSizeWarmBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexSizeWarmBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexSizeWarmBytes(cfg MetricConfig) metricSplunkIndexSizeWarmBytes {
+	m := metricSplunkIndexSizeWarmBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerAvgRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.avg.rate metric with initial data.
+func (m *metricSplunkIndexerAvgRate) init() {
+	m.data.SetName("splunk.indexer.avg.rate")
+	m.data.SetDescription("Gauge tracking the average rate of indexed data. **Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("KBy")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerAvgRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerAvgRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerAvgRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerAvgRate(cfg MetricConfig) metricSplunkIndexerAvgRate {
+	m := metricSplunkIndexerAvgRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerCPUTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.cpu.time metric with initial data.
+func (m *metricSplunkIndexerCPUTime) init() {
+	m.data.SetName("splunk.indexer.cpu.time")
+	m.data.SetDescription("Gauge tracking the number of indexing process cpu seconds per instance")
+	m.data.SetUnit("{s}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerCPUTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerCPUTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerCPUTime(cfg MetricConfig) metricSplunkIndexerCPUTime {
+	m := metricSplunkIndexerCPUTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerEventsDroppedNoIndex struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.events_dropped_no_index metric with initial data.
+func (m *metricSplunkIndexerEventsDroppedNoIndex) init() {
+	m.data.SetName("splunk.indexer.events_dropped_no_index")
+	m.data.SetDescription("Gauge tracking the number of events dropped over the last 10 minutes because they were routed to an index which is not configured, disabled or deleted, by the name of the missing index. Counted from the warnings the IndexProcessor logs for each such event. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerEventsDroppedNoIndex) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerEventsDroppedNoIndex) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerEventsDroppedNoIndex) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerEventsDroppedNoIndex(cfg MetricConfig) metricSplunkIndexerEventsDroppedNoIndex {
+	m := metricSplunkIndexerEventsDroppedNoIndex{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerEventsIndexed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.events_indexed metric with initial data.
+func (m *metricSplunkIndexerEventsIndexed) init() {
+	m.data.SetName("splunk.indexer.events_indexed")
+	m.data.SetDescription("Cumulative count of events indexed per index, reported as a monotonic sum so that rates can be derived downstream. Events rolled to frozen are no longer counted, which shows up as a counter reset. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerEventsIndexed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerEventsIndexed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerEventsIndexed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerEventsIndexed(cfg MetricConfig) metricSplunkIndexerEventsIndexed {
+	m := metricSplunkIndexerEventsIndexed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerQueueRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.queue.ratio metric with initial data.
+func (m *metricSplunkIndexerQueueRatio) init() {
+	m.data.SetName("splunk.indexer.queue.ratio")
+	m.data.SetDescription("Gauge tracking the average indexer index queue ration (%). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{%}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerQueueRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerQueueRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerQueueRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerQueueRatio(cfg MetricConfig) metricSplunkIndexerQueueRatio {
+	m := metricSplunkIndexerQueueRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerRawWriteTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.raw.write.time metric with initial data.
+func (m *metricSplunkIndexerRawWriteTime) init() {
+	m.data.SetName("splunk.indexer.raw.write.time")
+	m.data.SetDescription("Gauge tracking the number of raw write seconds per instance")
+	m.data.SetUnit("{s}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerRawWriteTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerRawWriteTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerRawWriteTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerRawWriteTime(cfg MetricConfig) metricSplunkIndexerRawWriteTime {
+	m := metricSplunkIndexerRawWriteTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerSearchesServed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.searches_served metric with initial data.
+func (m *metricSplunkIndexerSearchesServed) init() {
+	m.data.SetName("splunk.indexer.searches_served")
+	m.data.SetDescription("Gauge tracking the number of searches dispatched to each search peer by search heads over the last 10 minutes, revealing search load imbalance across indexers. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerSearchesServed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerSearchesServed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerSearchesServed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerSearchesServed(cfg MetricConfig) metricSplunkIndexerSearchesServed {
+	m := metricSplunkIndexerSearchesServed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerThrottledSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.throttled_seconds metric with initial data.
+func (m *metricSplunkIndexerThrottledSeconds) init() {
+	m.data.SetName("splunk.indexer.throttled_seconds")
+	m.data.SetDescription("Gauge tracking the number of seconds indexing was throttled on each host over the last 10 minutes, measured as the time its index queue reported being blocked in metrics.log.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerThrottledSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerThrottledSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerThrottledSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerThrottledSeconds(cfg MetricConfig) metricSplunkIndexerThrottledSeconds {
+	m := metricSplunkIndexerThrottledSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexerThroughput struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexer.throughput metric with initial data.
+func (m *metricSplunkIndexerThroughput) init() {
+	m.data.SetName("splunk.indexer.throughput")
+	m.data.SetDescription("Gauge tracking average bytes per second throughput of indexer. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexerThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.indexer.status", splunkIndexerStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexerThroughput) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexerThroughput) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexerThroughput(cfg MetricConfig) metricSplunkIndexerThroughput {
+	m := metricSplunkIndexerThroughput{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesAvgSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.avg.size metric with initial data.
+func (m *metricSplunkIndexesAvgSize) init() {
+	m.data.SetName("splunk.indexes.avg.size")
+	m.data.SetDescription("Gauge tracking the indexes and their average size (gb). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("Gb")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesAvgSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesAvgSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesAvgSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesAvgSize(cfg MetricConfig) metricSplunkIndexesAvgSize {
+	m := metricSplunkIndexesAvgSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesAvgUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.avg.usage metric with initial data.
+func (m *metricSplunkIndexesAvgUsage) init() {
+	m.data.SetName("splunk.indexes.avg.usage")
+	m.data.SetDescription("Gauge tracking the indexes and their average usage (%). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{%}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesAvgUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesAvgUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesAvgUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesAvgUsage(cfg MetricConfig) metricSplunkIndexesAvgUsage {
+	m := metricSplunkIndexesAvgUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesBucketCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.bucket.count metric with initial data.
+func (m *metricSplunkIndexesBucketCount) init() {
+	m.data.SetName("splunk.indexes.bucket.count")
+	m.data.SetDescription("Gauge tracking the indexes and their bucket counts. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{count}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesBucketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesBucketCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesBucketCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesBucketCount(cfg MetricConfig) metricSplunkIndexesBucketCount {
+	m := metricSplunkIndexesBucketCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesMedianDataAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.median.data.age metric with initial data.
+func (m *metricSplunkIndexesMedianDataAge) init() {
+	m.data.SetName("splunk.indexes.median.data.age")
+	m.data.SetDescription("Gauge tracking the indexes and their median data age (days). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{days}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesMedianDataAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesMedianDataAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesMedianDataAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesMedianDataAge(cfg MetricConfig) metricSplunkIndexesMedianDataAge {
+	m := metricSplunkIndexesMedianDataAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesSilentCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.silent.count metric with initial data.
+func (m *metricSplunkIndexesSilentCount) init() {
+	m.data.SetName("splunk.indexes.silent.count")
+	m.data.SetDescription("Gauge tracking the number of indexes which have not received data within `silent_index_threshold`. Indexes which never received any data are not counted. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{indexes}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkIndexesSilentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesSilentCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesSilentCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesSilentCount(cfg MetricConfig) metricSplunkIndexesSilentCount {
+	m := metricSplunkIndexesSilentCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIndexesSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.indexes.size metric with initial data.
+func (m *metricSplunkIndexesSize) init() {
+	m.data.SetName("splunk.indexes.size")
+	m.data.SetDescription("Gauge tracking the indexes and their total size (gb). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("Gb")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIndexesSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIndexesSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIndexesSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIndexesSize(cfg MetricConfig) metricSplunkIndexesSize {
+	m := metricSplunkIndexesSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIngestionErrors struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.ingestion.errors metric with initial data.
+func (m *metricSplunkIngestionErrors) init() {
+	m.data.SetName("splunk.ingestion.errors")
+	m.data.SetDescription("Gauge tracking the number of errors logged by ingestion pipeline processors over the last 10 minutes. Errors here typically mean dropped or truncated events.")
+	m.data.SetUnit("{errors}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIngestionErrors) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkComponentAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.Attributes().PutStr("splunk.component", splunkComponentAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIngestionErrors) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIngestionErrors) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIngestionErrors(cfg MetricConfig) metricSplunkIngestionErrors {
+	m := metricSplunkIngestionErrors{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIngestionTruncations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.ingestion.truncations metric with initial data.
+func (m *metricSplunkIngestionTruncations) init() {
+	m.data.SetName("splunk.ingestion.truncations")
+	m.data.SetDescription("Gauge tracking the number of events truncated over the last 10 minutes for exceeding the `TRUNCATE` limit of their sourcetype, by sourcetype. Counted from the warnings the LineBreakingProcessor logs for each truncated line. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIngestionTruncations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSourcetypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.sourcetype", splunkSourcetypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIngestionTruncations) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIngestionTruncations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIngestionTruncations(cfg MetricConfig) metricSplunkIngestionTruncations {
+	m := metricSplunkIngestionTruncations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkIoAvgIops struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.io.avg.iops metric with initial data.
+func (m *metricSplunkIoAvgIops) init() {
+	m.data.SetName("splunk.io.avg.iops")
+	m.data.SetDescription("Gauge tracking the average IOPs used per instance")
+	m.data.SetUnit("{iops}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkIoAvgIops) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkIoAvgIops) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkIoAvgIops) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkIoAvgIops(cfg MetricConfig) metricSplunkIoAvgIops {
+	m := metricSplunkIoAvgIops{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreCollectionSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.collection.size metric with initial data.
+func (m *metricSplunkKvstoreCollectionSize) init() {
+	m.data.SetName("splunk.kvstore.collection.size")
+	m.data.SetDescription("Gauge tracking the size of the documents of each KV store collection, as reported by the collection statistics introspection of the KV store. *Note:** Must be pointed at a search head.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreCollectionSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkKvstoreCollectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+	dp.Attributes().PutStr("splunk.kvstore.collection", splunkKvstoreCollectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreCollectionSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreCollectionSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreCollectionSize(cfg MetricConfig) metricSplunkKvstoreCollectionSize {
+	m := metricSplunkKvstoreCollectionSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreDiskUsedBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.disk_used_bytes metric with initial data.
+func (m *metricSplunkKvstoreDiskUsedBytes) init() {
+	m.data.SetName("splunk.kvstore.disk_used_bytes")
+	m.data.SetDescription("Gauge tracking the disk space used by the KV store. *Note:** Must be pointed at a search head.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkKvstoreDiskUsedBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreDiskUsedBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreDiskUsedBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreDiskUsedBytes(cfg MetricConfig) metricSplunkKvstoreDiskUsedBytes {
+	m := metricSplunkKvstoreDiskUsedBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreLookupsActive struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.lookups.active metric with initial data.
+func (m *metricSplunkKvstoreLookupsActive) init() {
+	m.data.SetName("splunk.kvstore.lookups.active")
+	m.data.SetDescription("Gauge tracking the number of lookups run against a KV store collection over the last 10 minutes, as recorded by the profiling introspection of the KV store. Correlate with slow searches to spot collections under pressure. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{lookups}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreLookupsActive) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+	dp.Attributes().PutStr("splunk.lookup.name", splunkLookupNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreLookupsActive) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreLookupsActive) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreLookupsActive(cfg MetricConfig) metricSplunkKvstoreLookupsActive {
+	m := metricSplunkKvstoreLookupsActive{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreLookupsSlow struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.lookups.slow metric with initial data.
+func (m *metricSplunkKvstoreLookupsSlow) init() {
+	m.data.SetName("splunk.kvstore.lookups.slow")
+	m.data.SetDescription("Gauge tracking the number of lookups run against a KV store collection over the last 10 minutes which took longer than 100ms, as recorded by the profiling introspection of the KV store. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{lookups}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreLookupsSlow) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+	dp.Attributes().PutStr("splunk.lookup.name", splunkLookupNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreLookupsSlow) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreLookupsSlow) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreLookupsSlow(cfg MetricConfig) metricSplunkKvstoreLookupsSlow {
+	m := metricSplunkKvstoreLookupsSlow{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreMemberUp struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.member.up metric with initial data.
+func (m *metricSplunkKvstoreMemberUp) init() {
+	m.data.SetName("splunk.kvstore.member.up")
+	m.data.SetDescription("Gauge tracking whether each member of the KV store replica set is up, 1 when it is the captain or a replicating member and 0 otherwise. A standalone KV store is reported as its only member, up when it is ready. *Note:** Must be pointed at a search head.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkKvstoreMemberUp) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkKvstoreMemberAttributeValue string, splunkKvstoreReplicationStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.kvstore.member", splunkKvstoreMemberAttributeValue)
+	dp.Attributes().PutStr("splunk.kvstore.replication_status", splunkKvstoreReplicationStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreMemberUp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreMemberUp) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreMemberUp(cfg MetricConfig) metricSplunkKvstoreMemberUp {
+	m := metricSplunkKvstoreMemberUp{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkKvstoreOplogWindowSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.kvstore.oplog.window_seconds metric with initial data.
+func (m *metricSplunkKvstoreOplogWindowSeconds) init() {
+	m.data.SetName("splunk.kvstore.oplog.window_seconds")
+	m.data.SetDescription("Gauge tracking the time span covered by the oplog of the KV store. Members falling further behind than this window can no longer replicate and need a full resync. *Note:** Must be pointed at a search head.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkKvstoreOplogWindowSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkKvstoreOplogWindowSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkKvstoreOplogWindowSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkKvstoreOplogWindowSeconds(cfg MetricConfig) metricSplunkKvstoreOplogWindowSeconds {
+	m := metricSplunkKvstoreOplogWindowSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLicenseIndexUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.license.index.usage metric with initial data.
+func (m *metricSplunkLicenseIndexUsage) init() {
+	m.data.SetName("splunk.license.index.usage")
+	m.data.SetDescription("Gauge tracking the indexed license usage per index")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLicenseIndexUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.index.name", splunkIndexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLicenseIndexUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLicenseIndexUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLicenseIndexUsage(cfg MetricConfig) metricSplunkLicenseIndexUsage {
+	m := metricSplunkLicenseIndexUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLookupCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.lookup.count metric with initial data.
+func (m *metricSplunkLookupCount) init() {
+	m.data.SetName("splunk.lookup.count")
+	m.data.SetDescription("Gauge tracking the number of lookup table files and KV store collections per app. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{lookups}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLookupCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLookupCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLookupCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLookupCount(cfg MetricConfig) metricSplunkLookupCount {
+	m := metricSplunkLookupCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkLookupSizeBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.lookup.size_bytes metric with initial data.
+func (m *metricSplunkLookupSizeBytes) init() {
+	m.data.SetName("splunk.lookup.size_bytes")
+	m.data.SetDescription("Gauge tracking the size of each KV store collection. Lookup table files are not included as their size is not reported by the REST API. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkLookupSizeBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.app.name", splunkAppNameAttributeValue)
+	dp.Attributes().PutStr("splunk.lookup.name", splunkLookupNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkLookupSizeBytes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkLookupSizeBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkLookupSizeBytes(cfg MetricConfig) metricSplunkLookupSizeBytes {
+	m := metricSplunkLookupSizeBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkParseQueueRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.parse.queue.ratio metric with initial data.
+func (m *metricSplunkParseQueueRatio) init() {
+	m.data.SetName("splunk.parse.queue.ratio")
+	m.data.SetDescription("Gauge tracking the average indexer parser queue ration (%). *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{%}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkParseQueueRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkParseQueueRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkParseQueueRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkParseQueueRatio(cfg MetricConfig) metricSplunkParseQueueRatio {
+	m := metricSplunkParseQueueRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkPipelineSetCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.pipeline.set.count metric with initial data.
+func (m *metricSplunkPipelineSetCount) init() {
+	m.data.SetName("splunk.pipeline.set.count")
+	m.data.SetDescription("Gauge tracking the number of pipeline sets per indexer. **Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("KBy")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkPipelineSetCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkPipelineSetCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkPipelineSetCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkPipelineSetCount(cfg MetricConfig) metricSplunkPipelineSetCount {
+	m := metricSplunkPipelineSetCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkQueueEventsPerSecond struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.queue.events_per_second metric with initial data.
+func (m *metricSplunkQueueEventsPerSecond) init() {
+	m.data.SetName("splunk.queue.events_per_second")
+	m.data.SetDescription("Gauge tracking the average number of events per second read from each pipeline queue over the last 10 minutes, complementing the fill ratios of the queues.")
+	m.data.SetUnit("{events}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkQueueEventsPerSecond) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string, splunkQueueNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkQueueEventsPerSecond) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkQueueEventsPerSecond) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkQueueEventsPerSecond(cfg MetricConfig) metricSplunkQueueEventsPerSecond {
+	m := metricSplunkQueueEventsPerSecond{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverRequestDNSTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.request.dns.time metric with initial data.
+func (m *metricSplunkReceiverRequestDNSTime) init() {
+	m.data.SetName("splunk.receiver.request.dns.time")
+	m.data.SetDescription("Gauge tracking the longest time the DNS lookup for a connection to an endpoint took during the scrape. Requests reusing a connection, or to an endpoint configured by IP address, do not look it up.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverRequestDNSTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.endpoint", splunkEndpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverRequestDNSTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverRequestDNSTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverRequestDNSTime(cfg MetricConfig) metricSplunkReceiverRequestDNSTime {
+	m := metricSplunkReceiverRequestDNSTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverRequestFirstByteTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.request.first_byte.time metric with initial data.
+func (m *metricSplunkReceiverRequestFirstByteTime) init() {
+	m.data.SetName("splunk.receiver.request.first_byte.time")
+	m.data.SetDescription("Gauge tracking the longest time an endpoint took during the scrape to start responding once a request was written, which is the time Splunk spends on the request rather than on the network.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverRequestFirstByteTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.endpoint", splunkEndpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverRequestFirstByteTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverRequestFirstByteTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverRequestFirstByteTime(cfg MetricConfig) metricSplunkReceiverRequestFirstByteTime {
+	m := metricSplunkReceiverRequestFirstByteTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverRequestTLSHandshakeTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.request.tls_handshake.time metric with initial data.
+func (m *metricSplunkReceiverRequestTLSHandshakeTime) init() {
+	m.data.SetName("splunk.receiver.request.tls_handshake.time")
+	m.data.SetDescription("Gauge tracking the longest time the TLS handshake of a connection to an endpoint took during the scrape. Requests reusing a connection do not perform one.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverRequestTLSHandshakeTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.endpoint", splunkEndpointAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverRequestTLSHandshakeTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverRequestTLSHandshakeTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverRequestTLSHandshakeTime(cfg MetricConfig) metricSplunkReceiverRequestTLSHandshakeTime {
+	m := metricSplunkReceiverRequestTLSHandshakeTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchEventCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.event_count metric with initial data.
+func (m *metricSplunkReceiverSearchEventCount) init() {
+	m.data.SetName("splunk.receiver.search.event_count")
+	m.data.SetDescription("Gauge tracking the number of events returned by a search run by the receiver. Requires an additional request per search.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchEventCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchEventCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchEventCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchEventCount(cfg MetricConfig) metricSplunkReceiverSearchEventCount {
+	m := metricSplunkReceiverSearchEventCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchFieldState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.field.state metric with initial data.
+func (m *metricSplunkReceiverSearchFieldState) init() {
+	m.data.SetName("splunk.receiver.search.field.state")
+	m.data.SetDescription("Gauge reporting the integer that `state_fields` maps a string result field value of a search to, with the string value as an attribute. Only reported for the searches and fields configured in `state_fields`.")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchFieldState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string, splunkSearchFieldAttributeValue string, splunkSearchFieldValueAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+	dp.Attributes().PutStr("splunk.search.field", splunkSearchFieldAttributeValue)
+	dp.Attributes().PutStr("splunk.search.field.value", splunkSearchFieldValueAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchFieldState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchFieldState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchFieldState(cfg MetricConfig) metricSplunkReceiverSearchFieldState {
+	m := metricSplunkReceiverSearchFieldState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchResultCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.result_count metric with initial data.
+func (m *metricSplunkReceiverSearchResultCount) init() {
+	m.data.SetName("splunk.receiver.search.result_count")
+	m.data.SetDescription("Gauge tracking the number of results produced by a search run by the receiver. Requires an additional request per search.")
+	m.data.SetUnit("{results}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchResultCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchResultCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchResultCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchResultCount(cfg MetricConfig) metricSplunkReceiverSearchResultCount {
+	m := metricSplunkReceiverSearchResultCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.rows metric with initial data.
+func (m *metricSplunkReceiverSearchRows) init() {
+	m.data.SetName("splunk.receiver.search.rows")
+	m.data.SetDescription("Gauge tracking the number of result rows the receiver parsed from a search it ran.")
+	m.data.SetUnit("{rows}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchRows) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchRows) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchRows(cfg MetricConfig) metricSplunkReceiverSearchRows {
+	m := metricSplunkReceiverSearchRows{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchScanCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.scan_count metric with initial data.
+func (m *metricSplunkReceiverSearchScanCount) init() {
+	m.data.SetName("splunk.receiver.search.scan_count")
+	m.data.SetDescription("Gauge tracking the number of events scanned by a search run by the receiver. Requires an additional request per search.")
+	m.data.SetUnit("{events}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchScanCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchScanCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchScanCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchScanCount(cfg MetricConfig) metricSplunkReceiverSearchScanCount {
+	m := metricSplunkReceiverSearchScanCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkReceiverSearchTimeout struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.receiver.search.timeout metric with initial data.
+func (m *metricSplunkReceiverSearchTimeout) init() {
+	m.data.SetName("splunk.receiver.search.timeout")
+	m.data.SetDescription("Number of times a search run by the receiver did not complete within the scrape timeout since the receiver started.")
+	m.data.SetUnit("{timeouts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkReceiverSearchTimeout) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("search_name", searchNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkReceiverSearchTimeout) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkReceiverSearchTimeout) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkReceiverSearchTimeout(cfg MetricConfig) metricSplunkReceiverSearchTimeout {
+	m := metricSplunkReceiverSearchTimeout{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerAvgExecutionLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.avg.execution.latency metric with initial data.
+func (m *metricSplunkSchedulerAvgExecutionLatency) init() {
+	m.data.SetName("splunk.scheduler.avg.execution.latency")
+	m.data.SetDescription("Gauge tracking the average execution latency of scheduled searches")
+	m.data.SetUnit("{ms}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerAvgExecutionLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerAvgExecutionLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerAvgExecutionLatency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerAvgExecutionLatency(cfg MetricConfig) metricSplunkSchedulerAvgExecutionLatency {
+	m := metricSplunkSchedulerAvgExecutionLatency{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerAvgRunTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.avg.run.time metric with initial data.
+func (m *metricSplunkSchedulerAvgRunTime) init() {
+	m.data.SetName("splunk.scheduler.avg.run.time")
+	m.data.SetDescription("Gauge tracking the average runtime of scheduled searches")
+	m.data.SetUnit("{ms}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerAvgRunTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerAvgRunTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerAvgRunTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerAvgRunTime(cfg MetricConfig) metricSplunkSchedulerAvgRunTime {
+	m := metricSplunkSchedulerAvgRunTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerCompletionRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.completion.ratio metric with initial data.
+func (m *metricSplunkSchedulerCompletionRatio) init() {
+	m.data.SetName("splunk.scheduler.completion.ratio")
+	m.data.SetDescription("Gauge tracking the ratio of completed to skipped scheduled searches")
+	m.data.SetUnit("{%}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerCompletionRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerCompletionRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerCompletionRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerCompletionRatio(cfg MetricConfig) metricSplunkSchedulerCompletionRatio {
+	m := metricSplunkSchedulerCompletionRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerContinuedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.continued.count metric with initial data.
+func (m *metricSplunkSchedulerContinuedCount) init() {
+	m.data.SetName("splunk.scheduler.continued.count")
+	m.data.SetDescription("Gauge tracking the number of times each scheduled search was continued over the last 10 minutes because its previous run had not completed. Continued searches run longer than their schedule interval and can cascade into skipped searches.")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerContinuedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSavedsearchAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.savedsearch", splunkSavedsearchAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerContinuedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerContinuedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerContinuedCount(cfg MetricConfig) metricSplunkSchedulerContinuedCount {
+	m := metricSplunkSchedulerContinuedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerDelegatedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.delegated.count metric with initial data.
+func (m *metricSplunkSchedulerDelegatedCount) init() {
+	m.data.SetName("splunk.scheduler.delegated.count")
+	m.data.SetDescription("Gauge tracking the number of scheduled searches the search head cluster captain delegated to each member over the last 10 minutes. Useful to spot an uneven distribution of the scheduled search load. *Note:** Must be pointed at a cluster master `endpoint` with access to the search head cluster's internal logs.")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerDelegatedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerDelegatedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerDelegatedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerDelegatedCount(cfg MetricConfig) metricSplunkSchedulerDelegatedCount {
+	m := metricSplunkSchedulerDelegatedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerSkippedCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.skipped.count metric with initial data.
+func (m *metricSplunkSchedulerSkippedCount) init() {
+	m.data.SetName("splunk.scheduler.skipped.count")
+	m.data.SetDescription("Gauge tracking the number of scheduled searches skipped over the last 10 minutes by each host, by the reason they were skipped for. Skips for reaching the maximum number of concurrent searches are a sign of scheduler saturation. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSplunkSchedulerSkippedCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkSchedulerSkipReasonAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.Attributes().PutStr("splunk.scheduler.skip_reason", splunkSchedulerSkipReasonAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerSkippedCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerSkippedCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerSkippedCount(cfg MetricConfig) metricSplunkSchedulerSkippedCount {
+	m := metricSplunkSchedulerSkippedCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkSchedulerSkippedTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scheduler.skipped.total metric with initial data.
+func (m *metricSplunkSchedulerSkippedTotal) init() {
+	m.data.SetName("splunk.scheduler.skipped.total")
+	m.data.SetDescription("Gauge tracking the number of scheduled searches skipped over the last 10 minutes across all hosts and searches, as a single signal to alert on. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{searches}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkSchedulerSkippedTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkSchedulerSkippedTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkSchedulerSkippedTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkSchedulerSkippedTotal(cfg MetricConfig) metricSplunkSchedulerSkippedTotal {
+	m := metricSplunkSchedulerSkippedTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkScrapeDurationSeconds struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scrape.duration_seconds metric with initial data.
+func (m *metricSplunkScrapeDurationSeconds) init() {
+	m.data.SetName("splunk.scrape.duration_seconds")
+	m.data.SetDescription("Gauge tracking how long the scrape of all the enabled metrics took, like the `scrape_duration_seconds` of Prometheus.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkScrapeDurationSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSplunkScrapeDurationSeconds) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSplunkScrapeDurationSeconds) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSplunkScrapeDurationSeconds(cfg MetricConfig) metricSplunkScrapeDurationSeconds {
+	m := metricSplunkScrapeDurationSeconds{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSplunkScrapeSuccess struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills splunk.scrape.success metric with initial data.
+func (m *metricSplunkScrapeSuccess) init() {
+	m.data.SetName("splunk.scrape.success")
+	m.data.SetDescription("Gauge reporting whether the scrape succeeded, 1 when it did and 0 when it failed, like the `up` metric of Prometheus. A scrape failing for some metrics only, such as one cut short by `max_scrape_duration`, still counts as a success. When the scrape fails, the other metrics are not emitted.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSplunkScrapeSuccess) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkParseQueueRatio) updateCapacity() {
+func (m *metricSplunkScrapeSuccess) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkParseQueueRatio) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkScrapeSuccess) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1128,8 +4839,8 @@ func (m *metricSplunkParseQueueRatio) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkParseQueueRatio(cfg MetricConfig) metricSplunkParseQueueRatio {
-	m := metricSplunkParseQueueRatio{config: cfg}
+func newMetricSplunkScrapeSuccess(cfg MetricConfig) metricSplunkScrapeSuccess {
+	m := metricSplunkScrapeSuccess{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1137,22 +4848,22 @@ func newMetricSplunkParseQueueRatio(cfg MetricConfig) metricSplunkParseQueueRati
 	return m
 }
 
-type metricSplunkPipelineSetCount struct {
+type metricSplunkSearchesConcurrent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.pipeline.set.count metric with initial data.
-func (m *metricSplunkPipelineSetCount) init() {
-	m.data.SetName("splunk.pipeline.set.count")
-	m.data.SetDescription("Gauge tracking the number of pipeline sets per indexer. **Note:** Search is best run against a Cluster Manager.")
-	m.data.SetUnit("KBy")
+// init fills splunk.searches.concurrent metric with initial data.
+func (m *metricSplunkSearchesConcurrent) init() {
+	m.data.SetName("splunk.searches.concurrent")
+	m.data.SetDescription("Gauge tracking the number of searches currently running on the search head, counted from the resource usage of its search processes. Use it to watch search load against the concurrency limits. *Note:** Must be pointed at a search head `endpoint`.")
+	m.data.SetUnit("{searches}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkPipelineSetCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+func (m *metricSplunkSearchesConcurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1164,14 +4875,14 @@ func (m *metricSplunkPipelineSetCount) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkPipelineSetCount) updateCapacity() {
+func (m *metricSplunkSearchesConcurrent) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkPipelineSetCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkSearchesConcurrent) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1179,8 +4890,8 @@ func (m *metricSplunkPipelineSetCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkPipelineSetCount(cfg MetricConfig) metricSplunkPipelineSetCount {
-	m := metricSplunkPipelineSetCount{config: cfg}
+func newMetricSplunkSearchesConcurrent(cfg MetricConfig) metricSplunkSearchesConcurrent {
+	m := metricSplunkSearchesConcurrent{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1188,41 +4899,41 @@ func newMetricSplunkPipelineSetCount(cfg MetricConfig) metricSplunkPipelineSetCo
 	return m
 }
 
-type metricSplunkSchedulerAvgExecutionLatency struct {
+type metricSplunkSearchesLongRunningCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.scheduler.avg.execution.latency metric with initial data.
-func (m *metricSplunkSchedulerAvgExecutionLatency) init() {
-	m.data.SetName("splunk.scheduler.avg.execution.latency")
-	m.data.SetDescription("Gauge tracking the average execution latency of scheduled searches")
-	m.data.SetUnit("{ms}")
+// init fills splunk.searches.long_running.count metric with initial data.
+func (m *metricSplunkSearchesLongRunningCount) init() {
+	m.data.SetName("splunk.searches.long_running.count")
+	m.data.SetDescription("Gauge tracking the number of searches which completed over the last 10 minutes after running for longer than the `LongRunningSearchSeconds` search variable, by the user who ran them. Counted from the audit trail. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{searches}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkSchedulerAvgExecutionLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkSearchesLongRunningCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkUserAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.user", splunkUserAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkSchedulerAvgExecutionLatency) updateCapacity() {
+func (m *metricSplunkSearchesLongRunningCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkSchedulerAvgExecutionLatency) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkSearchesLongRunningCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1230,8 +4941,8 @@ func (m *metricSplunkSchedulerAvgExecutionLatency) emit(metrics pmetric.MetricSl
 	}
 }
 
-func newMetricSplunkSchedulerAvgExecutionLatency(cfg MetricConfig) metricSplunkSchedulerAvgExecutionLatency {
-	m := metricSplunkSchedulerAvgExecutionLatency{config: cfg}
+func newMetricSplunkSearchesLongRunningCount(cfg MetricConfig) metricSplunkSearchesLongRunningCount {
+	m := metricSplunkSearchesLongRunningCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1239,41 +4950,41 @@ func newMetricSplunkSchedulerAvgExecutionLatency(cfg MetricConfig) metricSplunkS
 	return m
 }
 
-type metricSplunkSchedulerAvgRunTime struct {
+type metricSplunkServerIntrospectionQueuesCurrent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.scheduler.avg.run.time metric with initial data.
-func (m *metricSplunkSchedulerAvgRunTime) init() {
-	m.data.SetName("splunk.scheduler.avg.run.time")
-	m.data.SetDescription("Gauge tracking the average runtime of scheduled searches")
-	m.data.SetUnit("{ms}")
+// init fills splunk.server.introspection.queues.current metric with initial data.
+func (m *metricSplunkServerIntrospectionQueuesCurrent) init() {
+	m.data.SetName("splunk.server.introspection.queues.current")
+	m.data.SetDescription("Gauge tracking current length of queue. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("{queues}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkSchedulerAvgRunTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkServerIntrospectionQueuesCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkQueueNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkSchedulerAvgRunTime) updateCapacity() {
+func (m *metricSplunkServerIntrospectionQueuesCurrent) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkSchedulerAvgRunTime) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkServerIntrospectionQueuesCurrent) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1281,8 +4992,8 @@ func (m *metricSplunkSchedulerAvgRunTime) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricSplunkSchedulerAvgRunTime(cfg MetricConfig) metricSplunkSchedulerAvgRunTime {
-	m := metricSplunkSchedulerAvgRunTime{config: cfg}
+func newMetricSplunkServerIntrospectionQueuesCurrent(cfg MetricConfig) metricSplunkServerIntrospectionQueuesCurrent {
+	m := metricSplunkServerIntrospectionQueuesCurrent{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1290,41 +5001,41 @@ func newMetricSplunkSchedulerAvgRunTime(cfg MetricConfig) metricSplunkSchedulerA
 	return m
 }
 
-type metricSplunkSchedulerCompletionRatio struct {
+type metricSplunkServerIntrospectionQueuesCurrentBytes struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.scheduler.completion.ratio metric with initial data.
-func (m *metricSplunkSchedulerCompletionRatio) init() {
-	m.data.SetName("splunk.scheduler.completion.ratio")
-	m.data.SetDescription("Gauge tracking the ratio of completed to skipped scheduled searches")
-	m.data.SetUnit("{%}")
+// init fills splunk.server.introspection.queues.current.bytes metric with initial data.
+func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) init() {
+	m.data.SetName("splunk.server.introspection.queues.current.bytes")
+	m.data.SetDescription("Gauge tracking current bytes waiting in queue. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
+	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkSchedulerCompletionRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
+func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkQueueNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleValue(val)
-	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkSchedulerCompletionRatio) updateCapacity() {
+func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkSchedulerCompletionRatio) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1332,8 +5043,8 @@ func (m *metricSplunkSchedulerCompletionRatio) emit(metrics pmetric.MetricSlice)
 	}
 }
 
-func newMetricSplunkSchedulerCompletionRatio(cfg MetricConfig) metricSplunkSchedulerCompletionRatio {
-	m := metricSplunkSchedulerCompletionRatio{config: cfg}
+func newMetricSplunkServerIntrospectionQueuesCurrentBytes(cfg MetricConfig) metricSplunkServerIntrospectionQueuesCurrentBytes {
+	m := metricSplunkServerIntrospectionQueuesCurrentBytes{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1341,22 +5052,22 @@ func newMetricSplunkSchedulerCompletionRatio(cfg MetricConfig) metricSplunkSched
 	return m
 }
 
-type metricSplunkServerIntrospectionQueuesCurrent struct {
+type metricSplunkServerUptimeSeconds struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.server.introspection.queues.current metric with initial data.
-func (m *metricSplunkServerIntrospectionQueuesCurrent) init() {
-	m.data.SetName("splunk.server.introspection.queues.current")
-	m.data.SetDescription("Gauge tracking current length of queue. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("{queues}")
+// init fills splunk.server.uptime_seconds metric with initial data.
+func (m *metricSplunkServerUptimeSeconds) init() {
+	m.data.SetName("splunk.server.uptime_seconds")
+	m.data.SetDescription("Gauge tracking the number of seconds since splunkd last started on each configured Splunk instance, for correlating restarts with changes in other metrics.")
+	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkServerIntrospectionQueuesCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkQueueNameAttributeValue string) {
+func (m *metricSplunkServerUptimeSeconds) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1364,18 +5075,18 @@ func (m *metricSplunkServerIntrospectionQueuesCurrent) recordDataPoint(start pco
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
+	dp.Attributes().PutStr("splunk.host", splunkHostAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkServerIntrospectionQueuesCurrent) updateCapacity() {
+func (m *metricSplunkServerUptimeSeconds) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkServerIntrospectionQueuesCurrent) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkServerUptimeSeconds) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1383,8 +5094,8 @@ func (m *metricSplunkServerIntrospectionQueuesCurrent) emit(metrics pmetric.Metr
 	}
 }
 
-func newMetricSplunkServerIntrospectionQueuesCurrent(cfg MetricConfig) metricSplunkServerIntrospectionQueuesCurrent {
-	m := metricSplunkServerIntrospectionQueuesCurrent{config: cfg}
+func newMetricSplunkServerUptimeSeconds(cfg MetricConfig) metricSplunkServerUptimeSeconds {
+	m := metricSplunkServerUptimeSeconds{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1392,22 +5103,22 @@ func newMetricSplunkServerIntrospectionQueuesCurrent(cfg MetricConfig) metricSpl
 	return m
 }
 
-type metricSplunkServerIntrospectionQueuesCurrentBytes struct {
+type metricSplunkSourcetypeEvents struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills splunk.server.introspection.queues.current.bytes metric with initial data.
-func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) init() {
-	m.data.SetName("splunk.server.introspection.queues.current.bytes")
-	m.data.SetDescription("Gauge tracking current bytes waiting in queue. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.")
-	m.data.SetUnit("By")
+// init fills splunk.sourcetype.events metric with initial data.
+func (m *metricSplunkSourcetypeEvents) init() {
+	m.data.SetName("splunk.sourcetype.events")
+	m.data.SetDescription("Gauge tracking the number of events indexed over the last 10 minutes for each sourcetype, summed across the indexers as reported by the `metadata` command. Use it to see which sourcetypes dominate ingestion. *Note:** Search is best run against a Cluster Manager.")
+	m.data.SetUnit("{events}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkQueueNameAttributeValue string) {
+func (m *metricSplunkSourcetypeEvents) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, splunkSourcetypeAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1415,18 +5126,18 @@ func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) recordDataPoint(star
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("splunk.queue.name", splunkQueueNameAttributeValue)
+	dp.Attributes().PutStr("splunk.sourcetype", splunkSourcetypeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) updateCapacity() {
+func (m *metricSplunkSourcetypeEvents) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) emit(metrics pmetric.MetricSlice) {
+func (m *metricSplunkSourcetypeEvents) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1434,8 +5145,8 @@ func (m *metricSplunkServerIntrospectionQueuesCurrentBytes) emit(metrics pmetric
 	}
 }
 
-func newMetricSplunkServerIntrospectionQueuesCurrentBytes(cfg MetricConfig) metricSplunkServerIntrospectionQueuesCurrentBytes {
-	m := metricSplunkServerIntrospectionQueuesCurrentBytes{config: cfg}
+func newMetricSplunkSourcetypeEvents(cfg MetricConfig) metricSplunkSourcetypeEvents {
+	m := metricSplunkSourcetypeEvents{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1503,7 +5214,22 @@ type MetricsBuilder struct {
 	metricsBuffer                                     pmetric.Metrics      // accumulates metrics data before emitting.
 	buildInfo                                         component.BuildInfo  // contains version information.
 	metricSplunkAggregationQueueRatio                 metricSplunkAggregationQueueRatio
+	metricSplunkAlertsFiring                          metricSplunkAlertsFiring
+	metricSplunkAlertsRealtimeCount                   metricSplunkAlertsRealtimeCount
 	metricSplunkBucketsSearchableStatus               metricSplunkBucketsSearchableStatus
+	metricSplunkBundlePushSizeBytes                   metricSplunkBundlePushSizeBytes
+	metricSplunkClusterBucketSyncLagSeconds           metricSplunkClusterBucketSyncLagSeconds
+	metricSplunkClusterBucketsReplicating             metricSplunkClusterBucketsReplicating
+	metricSplunkClusterFixupOldestAgeSeconds          metricSplunkClusterFixupOldestAgeSeconds
+	metricSplunkClusterFixupPending                   metricSplunkClusterFixupPending
+	metricSplunkClusterGenerationID                   metricSplunkClusterGenerationID
+	metricSplunkClusterIndexBucketsQuarantined        metricSplunkClusterIndexBucketsQuarantined
+	metricSplunkClusterIndexRfMetAtIngest             metricSplunkClusterIndexRfMetAtIngest
+	metricSplunkClusterIndexingReady                  metricSplunkClusterIndexingReady
+	metricSplunkClusterMaintenanceMode                metricSplunkClusterMaintenanceMode
+	metricSplunkClusterPeerGenerationLag              metricSplunkClusterPeerGenerationLag
+	metricSplunkClusterPeerJoins                      metricSplunkClusterPeerJoins
+	metricSplunkClusterPeerLeaves                     metricSplunkClusterPeerLeaves
 	metricSplunkDataIndexesExtendedBucketCount        metricSplunkDataIndexesExtendedBucketCount
 	metricSplunkDataIndexesExtendedBucketEventCount   metricSplunkDataIndexesExtendedBucketEventCount
 	metricSplunkDataIndexesExtendedBucketHotCount     metricSplunkDataIndexesExtendedBucketHotCount
@@ -1511,25 +5237,83 @@ type MetricsBuilder struct {
 	metricSplunkDataIndexesExtendedEventCount         metricSplunkDataIndexesExtendedEventCount
 	metricSplunkDataIndexesExtendedRawSize            metricSplunkDataIndexesExtendedRawSize
 	metricSplunkDataIndexesExtendedTotalSize          metricSplunkDataIndexesExtendedTotalSize
+	metricSplunkDatamodelBuildsRunning                metricSplunkDatamodelBuildsRunning
+	metricSplunkDispatchArtifactsPendingDeletion      metricSplunkDispatchArtifactsPendingDeletion
+	metricSplunkDmcInstances                          metricSplunkDmcInstances
+	metricSplunkDmcInstancesUnhealthy                 metricSplunkDmcInstancesUnhealthy
+	metricSplunkHealthBlockedQueues                   metricSplunkHealthBlockedQueues
+	metricSplunkIndexBucketAvgSizeBytes               metricSplunkIndexBucketAvgSizeBytes
+	metricSplunkIndexBucketMaxSizeBytes               metricSplunkIndexBucketMaxSizeBytes
+	metricSplunkIndexBucketMerges                     metricSplunkIndexBucketMerges
+	metricSplunkIndexBucketRolls                      metricSplunkIndexBucketRolls
+	metricSplunkIndexBucketUtilizationRatio           metricSplunkIndexBucketUtilizationRatio
+	metricSplunkIndexDaysUntilFull                    metricSplunkIndexDaysUntilFull
+	metricSplunkIndexEventsPerSecond                  metricSplunkIndexEventsPerSecond
+	metricSplunkIndexFrozenArchiveFailures            metricSplunkIndexFrozenArchiveFailures
+	metricSplunkIndexFrozenArchiveConfigured          metricSplunkIndexFrozenArchiveConfigured
+	metricSplunkIndexHotBucketsCurrent                metricSplunkIndexHotBucketsCurrent
+	metricSplunkIndexHotBucketsMax                    metricSplunkIndexHotBucketsMax
+	metricSplunkIndexMaxBuckets                       metricSplunkIndexMaxBuckets
+	metricSplunkIndexRetentionUtilizationRatio        metricSplunkIndexRetentionUtilizationRatio
+	metricSplunkIndexSearchableTest                   metricSplunkIndexSearchableTest
+	metricSplunkIndexSharedGlobally                   metricSplunkIndexSharedGlobally
+	metricSplunkIndexSizeColdBytes                    metricSplunkIndexSizeColdBytes
+	metricSplunkIndexSizeHotBytes                     metricSplunkIndexSizeHotBytes
+	metricSplunkIndexSizeWarmBytes                    metricSplunkIndexSizeWarmBytes
 	metricSplunkIndexerAvgRate                        metricSplunkIndexerAvgRate
 	metricSplunkIndexerCPUTime                        metricSplunkIndexerCPUTime
+	metricSplunkIndexerEventsDroppedNoIndex           metricSplunkIndexerEventsDroppedNoIndex
+	metricSplunkIndexerEventsIndexed                  metricSplunkIndexerEventsIndexed
 	metricSplunkIndexerQueueRatio                     metricSplunkIndexerQueueRatio
 	metricSplunkIndexerRawWriteTime                   metricSplunkIndexerRawWriteTime
+	metricSplunkIndexerSearchesServed                 metricSplunkIndexerSearchesServed
+	metricSplunkIndexerThrottledSeconds               metricSplunkIndexerThrottledSeconds
 	metricSplunkIndexerThroughput                     metricSplunkIndexerThroughput
 	metricSplunkIndexesAvgSize                        metricSplunkIndexesAvgSize
 	metricSplunkIndexesAvgUsage                       metricSplunkIndexesAvgUsage
 	metricSplunkIndexesBucketCount                    metricSplunkIndexesBucketCount
 	metricSplunkIndexesMedianDataAge                  metricSplunkIndexesMedianDataAge
+	metricSplunkIndexesSilentCount                    metricSplunkIndexesSilentCount
 	metricSplunkIndexesSize                           metricSplunkIndexesSize
+	metricSplunkIngestionErrors                       metricSplunkIngestionErrors
+	metricSplunkIngestionTruncations                  metricSplunkIngestionTruncations
 	metricSplunkIoAvgIops                             metricSplunkIoAvgIops
+	metricSplunkKvstoreCollectionSize                 metricSplunkKvstoreCollectionSize
+	metricSplunkKvstoreDiskUsedBytes                  metricSplunkKvstoreDiskUsedBytes
+	metricSplunkKvstoreLookupsActive                  metricSplunkKvstoreLookupsActive
+	metricSplunkKvstoreLookupsSlow                    metricSplunkKvstoreLookupsSlow
+	metricSplunkKvstoreMemberUp                       metricSplunkKvstoreMemberUp
+	metricSplunkKvstoreOplogWindowSeconds             metricSplunkKvstoreOplogWindowSeconds
 	metricSplunkLicenseIndexUsage                     metricSplunkLicenseIndexUsage
+	metricSplunkLookupCount                           metricSplunkLookupCount
+	metricSplunkLookupSizeBytes                       metricSplunkLookupSizeBytes
 	metricSplunkParseQueueRatio                       metricSplunkParseQueueRatio
 	metricSplunkPipelineSetCount                      metricSplunkPipelineSetCount
+	metricSplunkQueueEventsPerSecond                  metricSplunkQueueEventsPerSecond
+	metricSplunkReceiverRequestDNSTime                metricSplunkReceiverRequestDNSTime
+	metricSplunkReceiverRequestFirstByteTime          metricSplunkReceiverRequestFirstByteTime
+	metricSplunkReceiverRequestTLSHandshakeTime       metricSplunkReceiverRequestTLSHandshakeTime
+	metricSplunkReceiverSearchEventCount              metricSplunkReceiverSearchEventCount
+	metricSplunkReceiverSearchFieldState              metricSplunkReceiverSearchFieldState
+	metricSplunkReceiverSearchResultCount             metricSplunkReceiverSearchResultCount
+	metricSplunkReceiverSearchRows                    metricSplunkReceiverSearchRows
+	metricSplunkReceiverSearchScanCount               metricSplunkReceiverSearchScanCount
+	metricSplunkReceiverSearchTimeout                 metricSplunkReceiverSearchTimeout
 	metricSplunkSchedulerAvgExecutionLatency          metricSplunkSchedulerAvgExecutionLatency
 	metricSplunkSchedulerAvgRunTime                   metricSplunkSchedulerAvgRunTime
 	metricSplunkSchedulerCompletionRatio              metricSplunkSchedulerCompletionRatio
+	metricSplunkSchedulerContinuedCount               metricSplunkSchedulerContinuedCount
+	metricSplunkSchedulerDelegatedCount               metricSplunkSchedulerDelegatedCount
+	metricSplunkSchedulerSkippedCount                 metricSplunkSchedulerSkippedCount
+	metricSplunkSchedulerSkippedTotal                 metricSplunkSchedulerSkippedTotal
+	metricSplunkScrapeDurationSeconds                 metricSplunkScrapeDurationSeconds
+	metricSplunkScrapeSuccess                         metricSplunkScrapeSuccess
+	metricSplunkSearchesConcurrent                    metricSplunkSearchesConcurrent
+	metricSplunkSearchesLongRunningCount              metricSplunkSearchesLongRunningCount
 	metricSplunkServerIntrospectionQueuesCurrent      metricSplunkServerIntrospectionQueuesCurrent
 	metricSplunkServerIntrospectionQueuesCurrentBytes metricSplunkServerIntrospectionQueuesCurrentBytes
+	metricSplunkServerUptimeSeconds                   metricSplunkServerUptimeSeconds
+	metricSplunkSourcetypeEvents                      metricSplunkSourcetypeEvents
 	metricSplunkTypingQueueRatio                      metricSplunkTypingQueueRatio
 }
 
@@ -1545,12 +5329,27 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                              mbc,
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           settings.BuildInfo,
-		metricSplunkAggregationQueueRatio:   newMetricSplunkAggregationQueueRatio(mbc.Metrics.SplunkAggregationQueueRatio),
-		metricSplunkBucketsSearchableStatus: newMetricSplunkBucketsSearchableStatus(mbc.Metrics.SplunkBucketsSearchableStatus),
+		config:                                            mbc,
+		startTime:                                         pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                                     pmetric.NewMetrics(),
+		buildInfo:                                         settings.BuildInfo,
+		metricSplunkAggregationQueueRatio:                 newMetricSplunkAggregationQueueRatio(mbc.Metrics.SplunkAggregationQueueRatio),
+		metricSplunkAlertsFiring:                          newMetricSplunkAlertsFiring(mbc.Metrics.SplunkAlertsFiring),
+		metricSplunkAlertsRealtimeCount:                   newMetricSplunkAlertsRealtimeCount(mbc.Metrics.SplunkAlertsRealtimeCount),
+		metricSplunkBucketsSearchableStatus:               newMetricSplunkBucketsSearchableStatus(mbc.Metrics.SplunkBucketsSearchableStatus),
+		metricSplunkBundlePushSizeBytes:                   newMetricSplunkBundlePushSizeBytes(mbc.Metrics.SplunkBundlePushSizeBytes),
+		metricSplunkClusterBucketSyncLagSeconds:           newMetricSplunkClusterBucketSyncLagSeconds(mbc.Metrics.SplunkClusterBucketSyncLagSeconds),
+		metricSplunkClusterBucketsReplicating:             newMetricSplunkClusterBucketsReplicating(mbc.Metrics.SplunkClusterBucketsReplicating),
+		metricSplunkClusterFixupOldestAgeSeconds:          newMetricSplunkClusterFixupOldestAgeSeconds(mbc.Metrics.SplunkClusterFixupOldestAgeSeconds),
+		metricSplunkClusterFixupPending:                   newMetricSplunkClusterFixupPending(mbc.Metrics.SplunkClusterFixupPending),
+		metricSplunkClusterGenerationID:                   newMetricSplunkClusterGenerationID(mbc.Metrics.SplunkClusterGenerationID),
+		metricSplunkClusterIndexBucketsQuarantined:        newMetricSplunkClusterIndexBucketsQuarantined(mbc.Metrics.SplunkClusterIndexBucketsQuarantined),
+		metricSplunkClusterIndexRfMetAtIngest:             newMetricSplunkClusterIndexRfMetAtIngest(mbc.Metrics.SplunkClusterIndexRfMetAtIngest),
+		metricSplunkClusterIndexingReady:                  newMetricSplunkClusterIndexingReady(mbc.Metrics.SplunkClusterIndexingReady),
+		metricSplunkClusterMaintenanceMode:                newMetricSplunkClusterMaintenanceMode(mbc.Metrics.SplunkClusterMaintenanceMode),
+		metricSplunkClusterPeerGenerationLag:              newMetricSplunkClusterPeerGenerationLag(mbc.Metrics.SplunkClusterPeerGenerationLag),
+		metricSplunkClusterPeerJoins:                      newMetricSplunkClusterPeerJoins(mbc.Metrics.SplunkClusterPeerJoins),
+		metricSplunkClusterPeerLeaves:                     newMetricSplunkClusterPeerLeaves(mbc.Metrics.SplunkClusterPeerLeaves),
 		metricSplunkDataIndexesExtendedBucketCount:        newMetricSplunkDataIndexesExtendedBucketCount(mbc.Metrics.SplunkDataIndexesExtendedBucketCount),
 		metricSplunkDataIndexesExtendedBucketEventCount:   newMetricSplunkDataIndexesExtendedBucketEventCount(mbc.Metrics.SplunkDataIndexesExtendedBucketEventCount),
 		metricSplunkDataIndexesExtendedBucketHotCount:     newMetricSplunkDataIndexesExtendedBucketHotCount(mbc.Metrics.SplunkDataIndexesExtendedBucketHotCount),
@@ -1558,25 +5357,83 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSplunkDataIndexesExtendedEventCount:         newMetricSplunkDataIndexesExtendedEventCount(mbc.Metrics.SplunkDataIndexesExtendedEventCount),
 		metricSplunkDataIndexesExtendedRawSize:            newMetricSplunkDataIndexesExtendedRawSize(mbc.Metrics.SplunkDataIndexesExtendedRawSize),
 		metricSplunkDataIndexesExtendedTotalSize:          newMetricSplunkDataIndexesExtendedTotalSize(mbc.Metrics.SplunkDataIndexesExtendedTotalSize),
+		metricSplunkDatamodelBuildsRunning:                newMetricSplunkDatamodelBuildsRunning(mbc.Metrics.SplunkDatamodelBuildsRunning),
+		metricSplunkDispatchArtifactsPendingDeletion:      newMetricSplunkDispatchArtifactsPendingDeletion(mbc.Metrics.SplunkDispatchArtifactsPendingDeletion),
+		metricSplunkDmcInstances:                          newMetricSplunkDmcInstances(mbc.Metrics.SplunkDmcInstances),
+		metricSplunkDmcInstancesUnhealthy:                 newMetricSplunkDmcInstancesUnhealthy(mbc.Metrics.SplunkDmcInstancesUnhealthy),
+		metricSplunkHealthBlockedQueues:                   newMetricSplunkHealthBlockedQueues(mbc.Metrics.SplunkHealthBlockedQueues),
+		metricSplunkIndexBucketAvgSizeBytes:               newMetricSplunkIndexBucketAvgSizeBytes(mbc.Metrics.SplunkIndexBucketAvgSizeBytes),
+		metricSplunkIndexBucketMaxSizeBytes:               newMetricSplunkIndexBucketMaxSizeBytes(mbc.Metrics.SplunkIndexBucketMaxSizeBytes),
+		metricSplunkIndexBucketMerges:                     newMetricSplunkIndexBucketMerges(mbc.Metrics.SplunkIndexBucketMerges),
+		metricSplunkIndexBucketRolls:                      newMetricSplunkIndexBucketRolls(mbc.Metrics.SplunkIndexBucketRolls),
+		metricSplunkIndexBucketUtilizationRatio:           newMetricSplunkIndexBucketUtilizationRatio(mbc.Metrics.SplunkIndexBucketUtilizationRatio),
+		metricSplunkIndexDaysUntilFull:                    newMetricSplunkIndexDaysUntilFull(mbc.Metrics.SplunkIndexDaysUntilFull),
+		metricSplunkIndexEventsPerSecond:                  newMetricSplunkIndexEventsPerSecond(mbc.Metrics.SplunkIndexEventsPerSecond),
+		metricSplunkIndexFrozenArchiveFailures:            newMetricSplunkIndexFrozenArchiveFailures(mbc.Metrics.SplunkIndexFrozenArchiveFailures),
+		metricSplunkIndexFrozenArchiveConfigured:          newMetricSplunkIndexFrozenArchiveConfigured(mbc.Metrics.SplunkIndexFrozenArchiveConfigured),
+		metricSplunkIndexHotBucketsCurrent:                newMetricSplunkIndexHotBucketsCurrent(mbc.Metrics.SplunkIndexHotBucketsCurrent),
+		metricSplunkIndexHotBucketsMax:                    newMetricSplunkIndexHotBucketsMax(mbc.Metrics.SplunkIndexHotBucketsMax),
+		metricSplunkIndexMaxBuckets:                       newMetricSplunkIndexMaxBuckets(mbc.Metrics.SplunkIndexMaxBuckets),
+		metricSplunkIndexRetentionUtilizationRatio:        newMetricSplunkIndexRetentionUtilizationRatio(mbc.Metrics.SplunkIndexRetentionUtilizationRatio),
+		metricSplunkIndexSearchableTest:                   newMetricSplunkIndexSearchableTest(mbc.Metrics.SplunkIndexSearchableTest),
+		metricSplunkIndexSharedGlobally:                   newMetricSplunkIndexSharedGlobally(mbc.Metrics.SplunkIndexSharedGlobally),
+		metricSplunkIndexSizeColdBytes:                    newMetricSplunkIndexSizeColdBytes(mbc.Metrics.SplunkIndexSizeColdBytes),
+		metricSplunkIndexSizeHotBytes:                     newMetricSplunkIndexSizeHotBytes(mbc.Metrics.SplunkIndexSizeHotBytes),
+		metricSplunkIndexSizeWarmBytes:                    newMetricSplunkIndexSizeWarmBytes(mbc.Metrics.SplunkIndexSizeWarmBytes),
 		metricSplunkIndexerAvgRate:                        newMetricSplunkIndexerAvgRate(mbc.Metrics.SplunkIndexerAvgRate),
 		metricSplunkIndexerCPUTime:                        newMetricSplunkIndexerCPUTime(mbc.Metrics.SplunkIndexerCPUTime),
+		metricSplunkIndexerEventsDroppedNoIndex:           newMetricSplunkIndexerEventsDroppedNoIndex(mbc.Metrics.SplunkIndexerEventsDroppedNoIndex),
+		metricSplunkIndexerEventsIndexed:                  newMetricSplunkIndexerEventsIndexed(mbc.Metrics.SplunkIndexerEventsIndexed),
 		metricSplunkIndexerQueueRatio:                     newMetricSplunkIndexerQueueRatio(mbc.Metrics.SplunkIndexerQueueRatio),
 		metricSplunkIndexerRawWriteTime:                   newMetricSplunkIndexerRawWriteTime(mbc.Metrics.SplunkIndexerRawWriteTime),
+		metricSplunkIndexerSearchesServed:                 newMetricSplunkIndexerSearchesServed(mbc.Metrics.SplunkIndexerSearchesServed),
+		metricSplunkIndexerThrottledSeconds:               newMetricSplunkIndexerThrottledSeconds(mbc.Metrics.SplunkIndexerThrottledSeconds),
 		metricSplunkIndexerThroughput:                     newMetricSplunkIndexerThroughput(mbc.Metrics.SplunkIndexerThroughput),
 		metricSplunkIndexesAvgSize:                        newMetricSplunkIndexesAvgSize(mbc.Metrics.SplunkIndexesAvgSize),
 		metricSplunkIndexesAvgUsage:                       newMetricSplunkIndexesAvgUsage(mbc.Metrics.SplunkIndexesAvgUsage),
 		metricSplunkIndexesBucketCount:                    newMetricSplunkIndexesBucketCount(mbc.Metrics.SplunkIndexesBucketCount),
 		metricSplunkIndexesMedianDataAge:                  newMetricSplunkIndexesMedianDataAge(mbc.Metrics.SplunkIndexesMedianDataAge),
+		metricSplunkIndexesSilentCount:                    newMetricSplunkIndexesSilentCount(mbc.Metrics.SplunkIndexesSilentCount),
 		metricSplunkIndexesSize:                           newMetricSplunkIndexesSize(mbc.Metrics.SplunkIndexesSize),
+		metricSplunkIngestionErrors:                       newMetricSplunkIngestionErrors(mbc.Metrics.SplunkIngestionErrors),
+		metricSplunkIngestionTruncations:                  newMetricSplunkIngestionTruncations(mbc.Metrics.SplunkIngestionTruncations),
 		metricSplunkIoAvgIops:                             newMetricSplunkIoAvgIops(mbc.Metrics.SplunkIoAvgIops),
+		metricSplunkKvstoreCollectionSize:                 newMetricSplunkKvstoreCollectionSize(mbc.Metrics.SplunkKvstoreCollectionSize),
+		metricSplunkKvstoreDiskUsedBytes:                  newMetricSplunkKvstoreDiskUsedBytes(mbc.Metrics.SplunkKvstoreDiskUsedBytes),
+		metricSplunkKvstoreLookupsActive:                  newMetricSplunkKvstoreLookupsActive(mbc.Metrics.SplunkKvstoreLookupsActive),
+		metricSplunkKvstoreLookupsSlow:                    newMetricSplunkKvstoreLookupsSlow(mbc.Metrics.SplunkKvstoreLookupsSlow),
+		metricSplunkKvstoreMemberUp:                       newMetricSplunkKvstoreMemberUp(mbc.Metrics.SplunkKvstoreMemberUp),
+		metricSplunkKvstoreOplogWindowSeconds:             newMetricSplunkKvstoreOplogWindowSeconds(mbc.Metrics.SplunkKvstoreOplogWindowSeconds),
 		metricSplunkLicenseIndexUsage:                     newMetricSplunkLicenseIndexUsage(mbc.Metrics.SplunkLicenseIndexUsage),
+		metricSplunkLookupCount:                           newMetricSplunkLookupCount(mbc.Metrics.SplunkLookupCount),
+		metricSplunkLookupSizeBytes:                       newMetricSplunkLookupSizeBytes(mbc.Metrics.SplunkLookupSizeBytes),
 		metricSplunkParseQueueRatio:                       newMetricSplunkParseQueueRatio(mbc.Metrics.SplunkParseQueueRatio),
 		metricSplunkPipelineSetCount:                      newMetricSplunkPipelineSetCount(mbc.Metrics.SplunkPipelineSetCount),
+		metricSplunkQueueEventsPerSecond:                  newMetricSplunkQueueEventsPerSecond(mbc.Metrics.SplunkQueueEventsPerSecond),
+		metricSplunkReceiverRequestDNSTime:                newMetricSplunkReceiverRequestDNSTime(mbc.Metrics.SplunkReceiverRequestDNSTime),
+		metricSplunkReceiverRequestFirstByteTime:          newMetricSplunkReceiverRequestFirstByteTime(mbc.Metrics.SplunkReceiverRequestFirstByteTime),
+		metricSplunkReceiverRequestTLSHandshakeTime:       newMetricSplunkReceiverRequestTLSHandshakeTime(mbc.Metrics.SplunkReceiverRequestTLSHandshakeTime),
+		metricSplunkReceiverSearchEventCount:              newMetricSplunkReceiverSearchEventCount(mbc.Metrics.SplunkReceiverSearchEventCount),
+		metricSplunkReceiverSearchFieldState:              newMetricSplunkReceiverSearchFieldState(mbc.Metrics.SplunkReceiverSearchFieldState),
+		metricSplunkReceiverSearchResultCount:             newMetricSplunkReceiverSearchResultCount(mbc.Metrics.SplunkReceiverSearchResultCount),
+		metricSplunkReceiverSearchRows:                    newMetricSplunkReceiverSearchRows(mbc.Metrics.SplunkReceiverSearchRows),
+		metricSplunkReceiverSearchScanCount:               newMetricSplunkReceiverSearchScanCount(mbc.Metrics.SplunkReceiverSearchScanCount),
+		metricSplunkReceiverSearchTimeout:                 newMetricSplunkReceiverSearchTimeout(mbc.Metrics.SplunkReceiverSearchTimeout),
 		metricSplunkSchedulerAvgExecutionLatency:          newMetricSplunkSchedulerAvgExecutionLatency(mbc.Metrics.SplunkSchedulerAvgExecutionLatency),
 		metricSplunkSchedulerAvgRunTime:                   newMetricSplunkSchedulerAvgRunTime(mbc.Metrics.SplunkSchedulerAvgRunTime),
 		metricSplunkSchedulerCompletionRatio:              newMetricSplunkSchedulerCompletionRatio(mbc.Metrics.SplunkSchedulerCompletionRatio),
+		metricSplunkSchedulerContinuedCount:               newMetricSplunkSchedulerContinuedCount(mbc.Metrics.SplunkSchedulerContinuedCount),
+		metricSplunkSchedulerDelegatedCount:               newMetricSplunkSchedulerDelegatedCount(mbc.Metrics.SplunkSchedulerDelegatedCount),
+		metricSplunkSchedulerSkippedCount:                 newMetricSplunkSchedulerSkippedCount(mbc.Metrics.SplunkSchedulerSkippedCount),
+		metricSplunkSchedulerSkippedTotal:                 newMetricSplunkSchedulerSkippedTotal(mbc.Metrics.SplunkSchedulerSkippedTotal),
+		metricSplunkScrapeDurationSeconds:                 newMetricSplunkScrapeDurationSeconds(mbc.Metrics.SplunkScrapeDurationSeconds),
+		metricSplunkScrapeSuccess:                         newMetricSplunkScrapeSuccess(mbc.Metrics.SplunkScrapeSuccess),
+		metricSplunkSearchesConcurrent:                    newMetricSplunkSearchesConcurrent(mbc.Metrics.SplunkSearchesConcurrent),
+		metricSplunkSearchesLongRunningCount:              newMetricSplunkSearchesLongRunningCount(mbc.Metrics.SplunkSearchesLongRunningCount),
 		metricSplunkServerIntrospectionQueuesCurrent:      newMetricSplunkServerIntrospectionQueuesCurrent(mbc.Metrics.SplunkServerIntrospectionQueuesCurrent),
 		metricSplunkServerIntrospectionQueuesCurrentBytes: newMetricSplunkServerIntrospectionQueuesCurrentBytes(mbc.Metrics.SplunkServerIntrospectionQueuesCurrentBytes),
+		metricSplunkServerUptimeSeconds:                   newMetricSplunkServerUptimeSeconds(mbc.Metrics.SplunkServerUptimeSeconds),
+		metricSplunkSourcetypeEvents:                      newMetricSplunkSourcetypeEvents(mbc.Metrics.SplunkSourcetypeEvents),
 		metricSplunkTypingQueueRatio:                      newMetricSplunkTypingQueueRatio(mbc.Metrics.SplunkTypingQueueRatio),
 	}
 	for _, op := range options {
@@ -1635,7 +5492,22 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSplunkAggregationQueueRatio.emit(ils.Metrics())
+	mb.metricSplunkAlertsFiring.emit(ils.Metrics())
+	mb.metricSplunkAlertsRealtimeCount.emit(ils.Metrics())
 	mb.metricSplunkBucketsSearchableStatus.emit(ils.Metrics())
+	mb.metricSplunkBundlePushSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkClusterBucketSyncLagSeconds.emit(ils.Metrics())
+	mb.metricSplunkClusterBucketsReplicating.emit(ils.Metrics())
+	mb.metricSplunkClusterFixupOldestAgeSeconds.emit(ils.Metrics())
+	mb.metricSplunkClusterFixupPending.emit(ils.Metrics())
+	mb.metricSplunkClusterGenerationID.emit(ils.Metrics())
+	mb.metricSplunkClusterIndexBucketsQuarantined.emit(ils.Metrics())
+	mb.metricSplunkClusterIndexRfMetAtIngest.emit(ils.Metrics())
+	mb.metricSplunkClusterIndexingReady.emit(ils.Metrics())
+	mb.metricSplunkClusterMaintenanceMode.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerGenerationLag.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerJoins.emit(ils.Metrics())
+	mb.metricSplunkClusterPeerLeaves.emit(ils.Metrics())
 	mb.metricSplunkDataIndexesExtendedBucketCount.emit(ils.Metrics())
 	mb.metricSplunkDataIndexesExtendedBucketEventCount.emit(ils.Metrics())
 	mb.metricSplunkDataIndexesExtendedBucketHotCount.emit(ils.Metrics())
@@ -1643,25 +5515,83 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSplunkDataIndexesExtendedEventCount.emit(ils.Metrics())
 	mb.metricSplunkDataIndexesExtendedRawSize.emit(ils.Metrics())
 	mb.metricSplunkDataIndexesExtendedTotalSize.emit(ils.Metrics())
+	mb.metricSplunkDatamodelBuildsRunning.emit(ils.Metrics())
+	mb.metricSplunkDispatchArtifactsPendingDeletion.emit(ils.Metrics())
+	mb.metricSplunkDmcInstances.emit(ils.Metrics())
+	mb.metricSplunkDmcInstancesUnhealthy.emit(ils.Metrics())
+	mb.metricSplunkHealthBlockedQueues.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketAvgSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketMaxSizeBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketMerges.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketRolls.emit(ils.Metrics())
+	mb.metricSplunkIndexBucketUtilizationRatio.emit(ils.Metrics())
+	mb.metricSplunkIndexDaysUntilFull.emit(ils.Metrics())
+	mb.metricSplunkIndexEventsPerSecond.emit(ils.Metrics())
+	mb.metricSplunkIndexFrozenArchiveFailures.emit(ils.Metrics())
+	mb.metricSplunkIndexFrozenArchiveConfigured.emit(ils.Metrics())
+	mb.metricSplunkIndexHotBucketsCurrent.emit(ils.Metrics())
+	mb.metricSplunkIndexHotBucketsMax.emit(ils.Metrics())
+	mb.metricSplunkIndexMaxBuckets.emit(ils.Metrics())
+	mb.metricSplunkIndexRetentionUtilizationRatio.emit(ils.Metrics())
+	mb.metricSplunkIndexSearchableTest.emit(ils.Metrics())
+	mb.metricSplunkIndexSharedGlobally.emit(ils.Metrics())
+	mb.metricSplunkIndexSizeColdBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexSizeHotBytes.emit(ils.Metrics())
+	mb.metricSplunkIndexSizeWarmBytes.emit(ils.Metrics())
 	mb.metricSplunkIndexerAvgRate.emit(ils.Metrics())
 	mb.metricSplunkIndexerCPUTime.emit(ils.Metrics())
+	mb.metricSplunkIndexerEventsDroppedNoIndex.emit(ils.Metrics())
+	mb.metricSplunkIndexerEventsIndexed.emit(ils.Metrics())
 	mb.metricSplunkIndexerQueueRatio.emit(ils.Metrics())
 	mb.metricSplunkIndexerRawWriteTime.emit(ils.Metrics())
+	mb.metricSplunkIndexerSearchesServed.emit(ils.Metrics())
+	mb.metricSplunkIndexerThrottledSeconds.emit(ils.Metrics())
 	mb.metricSplunkIndexerThroughput.emit(ils.Metrics())
 	mb.metricSplunkIndexesAvgSize.emit(ils.Metrics())
 	mb.metricSplunkIndexesAvgUsage.emit(ils.Metrics())
 	mb.metricSplunkIndexesBucketCount.emit(ils.Metrics())
 	mb.metricSplunkIndexesMedianDataAge.emit(ils.Metrics())
+	mb.metricSplunkIndexesSilentCount.emit(ils.Metrics())
 	mb.metricSplunkIndexesSize.emit(ils.Metrics())
+	mb.metricSplunkIngestionErrors.emit(ils.Metrics())
+	mb.metricSplunkIngestionTruncations.emit(ils.Metrics())
 	mb.metricSplunkIoAvgIops.emit(ils.Metrics())
+	mb.metricSplunkKvstoreCollectionSize.emit(ils.Metrics())
+	mb.metricSplunkKvstoreDiskUsedBytes.emit(ils.Metrics())
+	mb.metricSplunkKvstoreLookupsActive.emit(ils.Metrics())
+	mb.metricSplunkKvstoreLookupsSlow.emit(ils.Metrics())
+	mb.metricSplunkKvstoreMemberUp.emit(ils.Metrics())
+	mb.metricSplunkKvstoreOplogWindowSeconds.emit(ils.Metrics())
 	mb.metricSplunkLicenseIndexUsage.emit(ils.Metrics())
+	mb.metricSplunkLookupCount.emit(ils.Metrics())
+	mb.metricSplunkLookupSizeBytes.emit(ils.Metrics())
 	mb.metricSplunkParseQueueRatio.emit(ils.Metrics())
 	mb.metricSplunkPipelineSetCount.emit(ils.Metrics())
+	mb.metricSplunkQueueEventsPerSecond.emit(ils.Metrics())
+	mb.metricSplunkReceiverRequestDNSTime.emit(ils.Metrics())
+	mb.metricSplunkReceiverRequestFirstByteTime.emit(ils.Metrics())
+	mb.metricSplunkReceiverRequestTLSHandshakeTime.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchEventCount.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchFieldState.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchResultCount.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchRows.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchScanCount.emit(ils.Metrics())
+	mb.metricSplunkReceiverSearchTimeout.emit(ils.Metrics())
 	mb.metricSplunkSchedulerAvgExecutionLatency.emit(ils.Metrics())
 	mb.metricSplunkSchedulerAvgRunTime.emit(ils.Metrics())
 	mb.metricSplunkSchedulerCompletionRatio.emit(ils.Metrics())
+	mb.metricSplunkSchedulerContinuedCount.emit(ils.Metrics())
+	mb.metricSplunkSchedulerDelegatedCount.emit(ils.Metrics())
+	mb.metricSplunkSchedulerSkippedCount.emit(ils.Metrics())
+	mb.metricSplunkSchedulerSkippedTotal.emit(ils.Metrics())
+	mb.metricSplunkScrapeDurationSeconds.emit(ils.Metrics())
+	mb.metricSplunkScrapeSuccess.emit(ils.Metrics())
+	mb.metricSplunkSearchesConcurrent.emit(ils.Metrics())
+	mb.metricSplunkSearchesLongRunningCount.emit(ils.Metrics())
 	mb.metricSplunkServerIntrospectionQueuesCurrent.emit(ils.Metrics())
 	mb.metricSplunkServerIntrospectionQueuesCurrentBytes.emit(ils.Metrics())
+	mb.metricSplunkServerUptimeSeconds.emit(ils.Metrics())
+	mb.metricSplunkSourcetypeEvents.emit(ils.Metrics())
 	mb.metricSplunkTypingQueueRatio.emit(ils.Metrics())
 
 	for _, op := range rmo {
@@ -1688,11 +5618,86 @@ func (mb *MetricsBuilder) RecordSplunkAggregationQueueRatioDataPoint(ts pcommon.
 	mb.metricSplunkAggregationQueueRatio.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkAlertsFiringDataPoint adds a data point to splunk.alerts.firing metric.
+func (mb *MetricsBuilder) RecordSplunkAlertsFiringDataPoint(ts pcommon.Timestamp, val int64, splunkSavedsearchAttributeValue string) {
+	mb.metricSplunkAlertsFiring.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchAttributeValue)
+}
+
+// RecordSplunkAlertsRealtimeCountDataPoint adds a data point to splunk.alerts.realtime.count metric.
+func (mb *MetricsBuilder) RecordSplunkAlertsRealtimeCountDataPoint(ts pcommon.Timestamp, val int64, splunkAlertModeAttributeValue string) {
+	mb.metricSplunkAlertsRealtimeCount.recordDataPoint(mb.startTime, ts, val, splunkAlertModeAttributeValue)
+}
+
 // RecordSplunkBucketsSearchableStatusDataPoint adds a data point to splunk.buckets.searchable.status metric.
 func (mb *MetricsBuilder) RecordSplunkBucketsSearchableStatusDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkIndexerSearchableAttributeValue string) {
 	mb.metricSplunkBucketsSearchableStatus.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue, splunkIndexerSearchableAttributeValue)
 }
 
+// RecordSplunkBundlePushSizeBytesDataPoint adds a data point to splunk.bundle.push.size_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkBundlePushSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
+	mb.metricSplunkBundlePushSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkPeerAttributeValue)
+}
+
+// RecordSplunkClusterBucketSyncLagSecondsDataPoint adds a data point to splunk.cluster.bucket_sync.lag_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkClusterBucketSyncLagSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
+	mb.metricSplunkClusterBucketSyncLagSeconds.recordDataPoint(mb.startTime, ts, val, splunkPeerAttributeValue)
+}
+
+// RecordSplunkClusterBucketsReplicatingDataPoint adds a data point to splunk.cluster.buckets.replicating metric.
+func (mb *MetricsBuilder) RecordSplunkClusterBucketsReplicatingDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterBucketsReplicating.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterFixupOldestAgeSecondsDataPoint adds a data point to splunk.cluster.fixup.oldest_age_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkClusterFixupOldestAgeSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkClusterFixupLevelAttributeValue string) {
+	mb.metricSplunkClusterFixupOldestAgeSeconds.recordDataPoint(mb.startTime, ts, val, splunkClusterFixupLevelAttributeValue)
+}
+
+// RecordSplunkClusterFixupPendingDataPoint adds a data point to splunk.cluster.fixup.pending metric.
+func (mb *MetricsBuilder) RecordSplunkClusterFixupPendingDataPoint(ts pcommon.Timestamp, val int64, splunkClusterFixupLevelAttributeValue string) {
+	mb.metricSplunkClusterFixupPending.recordDataPoint(mb.startTime, ts, val, splunkClusterFixupLevelAttributeValue)
+}
+
+// RecordSplunkClusterGenerationIDDataPoint adds a data point to splunk.cluster.generation_id metric.
+func (mb *MetricsBuilder) RecordSplunkClusterGenerationIDDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterGenerationID.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterIndexBucketsQuarantinedDataPoint adds a data point to splunk.cluster.index.buckets.quarantined metric.
+func (mb *MetricsBuilder) RecordSplunkClusterIndexBucketsQuarantinedDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkClusterIndexBucketsQuarantined.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkClusterIndexRfMetAtIngestDataPoint adds a data point to splunk.cluster.index.rf_met_at_ingest metric.
+func (mb *MetricsBuilder) RecordSplunkClusterIndexRfMetAtIngestDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkClusterIndexRfMetAtIngest.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkClusterIndexingReadyDataPoint adds a data point to splunk.cluster.indexing_ready metric.
+func (mb *MetricsBuilder) RecordSplunkClusterIndexingReadyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterIndexingReady.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterMaintenanceModeDataPoint adds a data point to splunk.cluster.maintenance_mode metric.
+func (mb *MetricsBuilder) RecordSplunkClusterMaintenanceModeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkClusterMaintenanceMode.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkClusterPeerGenerationLagDataPoint adds a data point to splunk.cluster.peer.generation_lag metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerGenerationLagDataPoint(ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
+	mb.metricSplunkClusterPeerGenerationLag.recordDataPoint(mb.startTime, ts, val, splunkPeerAttributeValue)
+}
+
+// RecordSplunkClusterPeerJoinsDataPoint adds a data point to splunk.cluster.peer_joins metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerJoinsDataPoint(ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
+	mb.metricSplunkClusterPeerJoins.recordDataPoint(mb.startTime, ts, val, splunkPeerAttributeValue)
+}
+
+// RecordSplunkClusterPeerLeavesDataPoint adds a data point to splunk.cluster.peer_leaves metric.
+func (mb *MetricsBuilder) RecordSplunkClusterPeerLeavesDataPoint(ts pcommon.Timestamp, val int64, splunkPeerAttributeValue string) {
+	mb.metricSplunkClusterPeerLeaves.recordDataPoint(mb.startTime, ts, val, splunkPeerAttributeValue)
+}
+
 // RecordSplunkDataIndexesExtendedBucketCountDataPoint adds a data point to splunk.data.indexes.extended.bucket.count metric.
 func (mb *MetricsBuilder) RecordSplunkDataIndexesExtendedBucketCountDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	mb.metricSplunkDataIndexesExtendedBucketCount.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
@@ -1728,6 +5733,121 @@ func (mb *MetricsBuilder) RecordSplunkDataIndexesExtendedTotalSizeDataPoint(ts p
 	mb.metricSplunkDataIndexesExtendedTotalSize.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
 }
 
+// RecordSplunkDatamodelBuildsRunningDataPoint adds a data point to splunk.datamodel.builds.running metric.
+func (mb *MetricsBuilder) RecordSplunkDatamodelBuildsRunningDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDatamodelBuildsRunning.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkDispatchArtifactsPendingDeletionDataPoint adds a data point to splunk.dispatch.artifacts.pending_deletion metric.
+func (mb *MetricsBuilder) RecordSplunkDispatchArtifactsPendingDeletionDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDispatchArtifactsPendingDeletion.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkDmcInstancesDataPoint adds a data point to splunk.dmc.instances metric.
+func (mb *MetricsBuilder) RecordSplunkDmcInstancesDataPoint(ts pcommon.Timestamp, val int64, splunkServerRoleAttributeValue string, splunkHealthStatusAttributeValue string) {
+	mb.metricSplunkDmcInstances.recordDataPoint(mb.startTime, ts, val, splunkServerRoleAttributeValue, splunkHealthStatusAttributeValue)
+}
+
+// RecordSplunkDmcInstancesUnhealthyDataPoint adds a data point to splunk.dmc.instances.unhealthy metric.
+func (mb *MetricsBuilder) RecordSplunkDmcInstancesUnhealthyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkDmcInstancesUnhealthy.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkHealthBlockedQueuesDataPoint adds a data point to splunk.health.blocked_queues metric.
+func (mb *MetricsBuilder) RecordSplunkHealthBlockedQueuesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkHealthBlockedQueues.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkIndexBucketAvgSizeBytesDataPoint adds a data point to splunk.index.bucket.avg_size_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketAvgSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketAvgSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketMaxSizeBytesDataPoint adds a data point to splunk.index.bucket.max_size_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketMaxSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketMaxSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketMergesDataPoint adds a data point to splunk.index.bucket_merges metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketMergesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketMerges.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketRollsDataPoint adds a data point to splunk.index.bucket_rolls metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketRollsDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketRolls.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexBucketUtilizationRatioDataPoint adds a data point to splunk.index.bucket_utilization_ratio metric.
+func (mb *MetricsBuilder) RecordSplunkIndexBucketUtilizationRatioDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexBucketUtilizationRatio.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexDaysUntilFullDataPoint adds a data point to splunk.index.days_until_full metric.
+func (mb *MetricsBuilder) RecordSplunkIndexDaysUntilFullDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexDaysUntilFull.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexEventsPerSecondDataPoint adds a data point to splunk.index.events_per_second metric.
+func (mb *MetricsBuilder) RecordSplunkIndexEventsPerSecondDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string, splunkHostAttributeValue string) {
+	mb.metricSplunkIndexEventsPerSecond.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue, splunkHostAttributeValue)
+}
+
+// RecordSplunkIndexFrozenArchiveFailuresDataPoint adds a data point to splunk.index.frozen.archive_failures metric.
+func (mb *MetricsBuilder) RecordSplunkIndexFrozenArchiveFailuresDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexFrozenArchiveFailures.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexFrozenArchiveConfiguredDataPoint adds a data point to splunk.index.frozen_archive_configured metric.
+func (mb *MetricsBuilder) RecordSplunkIndexFrozenArchiveConfiguredDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexFrozenArchiveConfigured.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexHotBucketsCurrentDataPoint adds a data point to splunk.index.hot_buckets.current metric.
+func (mb *MetricsBuilder) RecordSplunkIndexHotBucketsCurrentDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexHotBucketsCurrent.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexHotBucketsMaxDataPoint adds a data point to splunk.index.hot_buckets.max metric.
+func (mb *MetricsBuilder) RecordSplunkIndexHotBucketsMaxDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexHotBucketsMax.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexMaxBucketsDataPoint adds a data point to splunk.index.max_buckets metric.
+func (mb *MetricsBuilder) RecordSplunkIndexMaxBucketsDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexMaxBuckets.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexRetentionUtilizationRatioDataPoint adds a data point to splunk.index.retention_utilization_ratio metric.
+func (mb *MetricsBuilder) RecordSplunkIndexRetentionUtilizationRatioDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexRetentionUtilizationRatio.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSearchableTestDataPoint adds a data point to splunk.index.searchable_test metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSearchableTestDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSearchableTest.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSharedGloballyDataPoint adds a data point to splunk.index.shared_globally metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSharedGloballyDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSharedGlobally.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSizeColdBytesDataPoint adds a data point to splunk.index.size.cold_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSizeColdBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSizeColdBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSizeHotBytesDataPoint adds a data point to splunk.index.size.hot_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSizeHotBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSizeHotBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexSizeWarmBytesDataPoint adds a data point to splunk.index.size.warm_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkIndexSizeWarmBytesDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexSizeWarmBytes.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
 // RecordSplunkIndexerAvgRateDataPoint adds a data point to splunk.indexer.avg.rate metric.
 func (mb *MetricsBuilder) RecordSplunkIndexerAvgRateDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
 	mb.metricSplunkIndexerAvgRate.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
@@ -1738,6 +5858,16 @@ func (mb *MetricsBuilder) RecordSplunkIndexerCPUTimeDataPoint(ts pcommon.Timesta
 	mb.metricSplunkIndexerCPUTime.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkIndexerEventsDroppedNoIndexDataPoint adds a data point to splunk.indexer.events_dropped_no_index metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerEventsDroppedNoIndexDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexerEventsDroppedNoIndex.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
+// RecordSplunkIndexerEventsIndexedDataPoint adds a data point to splunk.indexer.events_indexed metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerEventsIndexedDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
+	mb.metricSplunkIndexerEventsIndexed.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
+}
+
 // RecordSplunkIndexerQueueRatioDataPoint adds a data point to splunk.indexer.queue.ratio metric.
 func (mb *MetricsBuilder) RecordSplunkIndexerQueueRatioDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
 	mb.metricSplunkIndexerQueueRatio.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
@@ -1748,6 +5878,16 @@ func (mb *MetricsBuilder) RecordSplunkIndexerRawWriteTimeDataPoint(ts pcommon.Ti
 	mb.metricSplunkIndexerRawWriteTime.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkIndexerSearchesServedDataPoint adds a data point to splunk.indexer.searches_served metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerSearchesServedDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	mb.metricSplunkIndexerSearchesServed.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
+}
+
+// RecordSplunkIndexerThrottledSecondsDataPoint adds a data point to splunk.indexer.throttled_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkIndexerThrottledSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	mb.metricSplunkIndexerThrottledSeconds.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
+}
+
 // RecordSplunkIndexerThroughputDataPoint adds a data point to splunk.indexer.throughput metric.
 func (mb *MetricsBuilder) RecordSplunkIndexerThroughputDataPoint(ts pcommon.Timestamp, val float64, splunkIndexerStatusAttributeValue string) {
 	mb.metricSplunkIndexerThroughput.recordDataPoint(mb.startTime, ts, val, splunkIndexerStatusAttributeValue)
@@ -1773,21 +5913,76 @@ func (mb *MetricsBuilder) RecordSplunkIndexesMedianDataAgeDataPoint(ts pcommon.T
 	mb.metricSplunkIndexesMedianDataAge.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
 }
 
+// RecordSplunkIndexesSilentCountDataPoint adds a data point to splunk.indexes.silent.count metric.
+func (mb *MetricsBuilder) RecordSplunkIndexesSilentCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkIndexesSilentCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordSplunkIndexesSizeDataPoint adds a data point to splunk.indexes.size metric.
 func (mb *MetricsBuilder) RecordSplunkIndexesSizeDataPoint(ts pcommon.Timestamp, val float64, splunkIndexNameAttributeValue string) {
 	mb.metricSplunkIndexesSize.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
 }
 
+// RecordSplunkIngestionErrorsDataPoint adds a data point to splunk.ingestion.errors metric.
+func (mb *MetricsBuilder) RecordSplunkIngestionErrorsDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkComponentAttributeValue string) {
+	mb.metricSplunkIngestionErrors.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue, splunkComponentAttributeValue)
+}
+
+// RecordSplunkIngestionTruncationsDataPoint adds a data point to splunk.ingestion.truncations metric.
+func (mb *MetricsBuilder) RecordSplunkIngestionTruncationsDataPoint(ts pcommon.Timestamp, val int64, splunkSourcetypeAttributeValue string) {
+	mb.metricSplunkIngestionTruncations.recordDataPoint(mb.startTime, ts, val, splunkSourcetypeAttributeValue)
+}
+
 // RecordSplunkIoAvgIopsDataPoint adds a data point to splunk.io.avg.iops metric.
 func (mb *MetricsBuilder) RecordSplunkIoAvgIopsDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
 	mb.metricSplunkIoAvgIops.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkKvstoreCollectionSizeDataPoint adds a data point to splunk.kvstore.collection.size metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreCollectionSizeDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkKvstoreCollectionAttributeValue string) {
+	mb.metricSplunkKvstoreCollectionSize.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue, splunkKvstoreCollectionAttributeValue)
+}
+
+// RecordSplunkKvstoreDiskUsedBytesDataPoint adds a data point to splunk.kvstore.disk_used_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreDiskUsedBytesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkKvstoreDiskUsedBytes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkKvstoreLookupsActiveDataPoint adds a data point to splunk.kvstore.lookups.active metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreLookupsActiveDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	mb.metricSplunkKvstoreLookupsActive.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue, splunkLookupNameAttributeValue)
+}
+
+// RecordSplunkKvstoreLookupsSlowDataPoint adds a data point to splunk.kvstore.lookups.slow metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreLookupsSlowDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	mb.metricSplunkKvstoreLookupsSlow.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue, splunkLookupNameAttributeValue)
+}
+
+// RecordSplunkKvstoreMemberUpDataPoint adds a data point to splunk.kvstore.member.up metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreMemberUpDataPoint(ts pcommon.Timestamp, val int64, splunkKvstoreMemberAttributeValue string, splunkKvstoreReplicationStatusAttributeValue string) {
+	mb.metricSplunkKvstoreMemberUp.recordDataPoint(mb.startTime, ts, val, splunkKvstoreMemberAttributeValue, splunkKvstoreReplicationStatusAttributeValue)
+}
+
+// RecordSplunkKvstoreOplogWindowSecondsDataPoint adds a data point to splunk.kvstore.oplog.window_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkKvstoreOplogWindowSecondsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkKvstoreOplogWindowSeconds.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordSplunkLicenseIndexUsageDataPoint adds a data point to splunk.license.index.usage metric.
 func (mb *MetricsBuilder) RecordSplunkLicenseIndexUsageDataPoint(ts pcommon.Timestamp, val int64, splunkIndexNameAttributeValue string) {
 	mb.metricSplunkLicenseIndexUsage.recordDataPoint(mb.startTime, ts, val, splunkIndexNameAttributeValue)
 }
 
+// RecordSplunkLookupCountDataPoint adds a data point to splunk.lookup.count metric.
+func (mb *MetricsBuilder) RecordSplunkLookupCountDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string) {
+	mb.metricSplunkLookupCount.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue)
+}
+
+// RecordSplunkLookupSizeBytesDataPoint adds a data point to splunk.lookup.size_bytes metric.
+func (mb *MetricsBuilder) RecordSplunkLookupSizeBytesDataPoint(ts pcommon.Timestamp, val int64, splunkAppNameAttributeValue string, splunkLookupNameAttributeValue string) {
+	mb.metricSplunkLookupSizeBytes.recordDataPoint(mb.startTime, ts, val, splunkAppNameAttributeValue, splunkLookupNameAttributeValue)
+}
+
 // RecordSplunkParseQueueRatioDataPoint adds a data point to splunk.parse.queue.ratio metric.
 func (mb *MetricsBuilder) RecordSplunkParseQueueRatioDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
 	mb.metricSplunkParseQueueRatio.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
@@ -1798,6 +5993,56 @@ func (mb *MetricsBuilder) RecordSplunkPipelineSetCountDataPoint(ts pcommon.Times
 	mb.metricSplunkPipelineSetCount.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkQueueEventsPerSecondDataPoint adds a data point to splunk.queue.events_per_second metric.
+func (mb *MetricsBuilder) RecordSplunkQueueEventsPerSecondDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string, splunkQueueNameAttributeValue string) {
+	mb.metricSplunkQueueEventsPerSecond.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue, splunkQueueNameAttributeValue)
+}
+
+// RecordSplunkReceiverRequestDNSTimeDataPoint adds a data point to splunk.receiver.request.dns.time metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverRequestDNSTimeDataPoint(ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	mb.metricSplunkReceiverRequestDNSTime.recordDataPoint(mb.startTime, ts, val, splunkEndpointAttributeValue)
+}
+
+// RecordSplunkReceiverRequestFirstByteTimeDataPoint adds a data point to splunk.receiver.request.first_byte.time metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverRequestFirstByteTimeDataPoint(ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	mb.metricSplunkReceiverRequestFirstByteTime.recordDataPoint(mb.startTime, ts, val, splunkEndpointAttributeValue)
+}
+
+// RecordSplunkReceiverRequestTLSHandshakeTimeDataPoint adds a data point to splunk.receiver.request.tls_handshake.time metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverRequestTLSHandshakeTimeDataPoint(ts pcommon.Timestamp, val float64, splunkEndpointAttributeValue string) {
+	mb.metricSplunkReceiverRequestTLSHandshakeTime.recordDataPoint(mb.startTime, ts, val, splunkEndpointAttributeValue)
+}
+
+// RecordSplunkReceiverSearchEventCountDataPoint adds a data point to splunk.receiver.search.event_count metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchEventCountDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchEventCount.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue)
+}
+
+// RecordSplunkReceiverSearchFieldStateDataPoint adds a data point to splunk.receiver.search.field.state metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchFieldStateDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string, splunkSearchFieldAttributeValue string, splunkSearchFieldValueAttributeValue string) {
+	mb.metricSplunkReceiverSearchFieldState.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue, splunkSearchFieldAttributeValue, splunkSearchFieldValueAttributeValue)
+}
+
+// RecordSplunkReceiverSearchResultCountDataPoint adds a data point to splunk.receiver.search.result_count metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchResultCountDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchResultCount.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue)
+}
+
+// RecordSplunkReceiverSearchRowsDataPoint adds a data point to splunk.receiver.search.rows metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchRowsDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchRows.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue)
+}
+
+// RecordSplunkReceiverSearchScanCountDataPoint adds a data point to splunk.receiver.search.scan_count metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchScanCountDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchScanCount.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue)
+}
+
+// RecordSplunkReceiverSearchTimeoutDataPoint adds a data point to splunk.receiver.search.timeout metric.
+func (mb *MetricsBuilder) RecordSplunkReceiverSearchTimeoutDataPoint(ts pcommon.Timestamp, val int64, searchNameAttributeValue string) {
+	mb.metricSplunkReceiverSearchTimeout.recordDataPoint(mb.startTime, ts, val, searchNameAttributeValue)
+}
+
 // RecordSplunkSchedulerAvgExecutionLatencyDataPoint adds a data point to splunk.scheduler.avg.execution.latency metric.
 func (mb *MetricsBuilder) RecordSplunkSchedulerAvgExecutionLatencyDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
 	mb.metricSplunkSchedulerAvgExecutionLatency.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
@@ -1813,6 +6058,46 @@ func (mb *MetricsBuilder) RecordSplunkSchedulerCompletionRatioDataPoint(ts pcomm
 	mb.metricSplunkSchedulerCompletionRatio.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
 }
 
+// RecordSplunkSchedulerContinuedCountDataPoint adds a data point to splunk.scheduler.continued.count metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerContinuedCountDataPoint(ts pcommon.Timestamp, val int64, splunkSavedsearchAttributeValue string) {
+	mb.metricSplunkSchedulerContinuedCount.recordDataPoint(mb.startTime, ts, val, splunkSavedsearchAttributeValue)
+}
+
+// RecordSplunkSchedulerDelegatedCountDataPoint adds a data point to splunk.scheduler.delegated.count metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerDelegatedCountDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	mb.metricSplunkSchedulerDelegatedCount.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
+}
+
+// RecordSplunkSchedulerSkippedCountDataPoint adds a data point to splunk.scheduler.skipped.count metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerSkippedCountDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string, splunkSchedulerSkipReasonAttributeValue string) {
+	mb.metricSplunkSchedulerSkippedCount.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue, splunkSchedulerSkipReasonAttributeValue)
+}
+
+// RecordSplunkSchedulerSkippedTotalDataPoint adds a data point to splunk.scheduler.skipped.total metric.
+func (mb *MetricsBuilder) RecordSplunkSchedulerSkippedTotalDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkSchedulerSkippedTotal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkScrapeDurationSecondsDataPoint adds a data point to splunk.scrape.duration_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkScrapeDurationSecondsDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSplunkScrapeDurationSeconds.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkScrapeSuccessDataPoint adds a data point to splunk.scrape.success metric.
+func (mb *MetricsBuilder) RecordSplunkScrapeSuccessDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSplunkScrapeSuccess.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSplunkSearchesConcurrentDataPoint adds a data point to splunk.searches.concurrent metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesConcurrentDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	mb.metricSplunkSearchesConcurrent.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
+}
+
+// RecordSplunkSearchesLongRunningCountDataPoint adds a data point to splunk.searches.long_running.count metric.
+func (mb *MetricsBuilder) RecordSplunkSearchesLongRunningCountDataPoint(ts pcommon.Timestamp, val int64, splunkUserAttributeValue string) {
+	mb.metricSplunkSearchesLongRunningCount.recordDataPoint(mb.startTime, ts, val, splunkUserAttributeValue)
+}
+
 // RecordSplunkServerIntrospectionQueuesCurrentDataPoint adds a data point to splunk.server.introspection.queues.current metric.
 func (mb *MetricsBuilder) RecordSplunkServerIntrospectionQueuesCurrentDataPoint(ts pcommon.Timestamp, val int64, splunkQueueNameAttributeValue string) {
 	mb.metricSplunkServerIntrospectionQueuesCurrent.recordDataPoint(mb.startTime, ts, val, splunkQueueNameAttributeValue)
@@ -1823,6 +6108,16 @@ func (mb *MetricsBuilder) RecordSplunkServerIntrospectionQueuesCurrentBytesDataP
 	mb.metricSplunkServerIntrospectionQueuesCurrentBytes.recordDataPoint(mb.startTime, ts, val, splunkQueueNameAttributeValue)
 }
 
+// RecordSplunkServerUptimeSecondsDataPoint adds a data point to splunk.server.uptime_seconds metric.
+func (mb *MetricsBuilder) RecordSplunkServerUptimeSecondsDataPoint(ts pcommon.Timestamp, val int64, splunkHostAttributeValue string) {
+	mb.metricSplunkServerUptimeSeconds.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
+}
+
+// RecordSplunkSourcetypeEventsDataPoint adds a data point to splunk.sourcetype.events metric.
+func (mb *MetricsBuilder) RecordSplunkSourcetypeEventsDataPoint(ts pcommon.Timestamp, val int64, splunkSourcetypeAttributeValue string) {
+	mb.metricSplunkSourcetypeEvents.recordDataPoint(mb.startTime, ts, val, splunkSourcetypeAttributeValue)
+}
+
 // RecordSplunkTypingQueueRatioDataPoint adds a data point to splunk.typing.queue.ratio metric.
 func (mb *MetricsBuilder) RecordSplunkTypingQueueRatioDataPoint(ts pcommon.Timestamp, val float64, splunkHostAttributeValue string) {
 	mb.metricSplunkTypingQueueRatio.recordDataPoint(mb.startTime, ts, val, splunkHostAttributeValue)
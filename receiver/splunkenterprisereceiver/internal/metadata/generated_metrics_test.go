@@ -59,10 +59,55 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkAggregationQueueRatioDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkAlertsFiringDataPoint(ts, 1, "splunk.savedsearch-val")
+
+			allMetricsCount++
+			mb.RecordSplunkAlertsRealtimeCountDataPoint(ts, 1, "splunk.alert.mode-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkBucketsSearchableStatusDataPoint(ts, 1, "splunk.host-val", "splunk.indexer.searchable-val")
 
+			allMetricsCount++
+			mb.RecordSplunkBundlePushSizeBytesDataPoint(ts, 1, "splunk.peer-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterBucketSyncLagSecondsDataPoint(ts, 1, "splunk.peer-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterBucketsReplicatingDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterFixupOldestAgeSecondsDataPoint(ts, 1, "splunk.cluster.fixup.level-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterFixupPendingDataPoint(ts, 1, "splunk.cluster.fixup.level-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterGenerationIDDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterIndexBucketsQuarantinedDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterIndexRfMetAtIngestDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterIndexingReadyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterMaintenanceModeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerGenerationLagDataPoint(ts, 1, "splunk.peer-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerJoinsDataPoint(ts, 1, "splunk.peer-val")
+
+			allMetricsCount++
+			mb.RecordSplunkClusterPeerLeavesDataPoint(ts, 1, "splunk.peer-val")
+
 			allMetricsCount++
 			mb.RecordSplunkDataIndexesExtendedBucketCountDataPoint(ts, 1, "splunk.index.name-val")
 
@@ -84,6 +129,75 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkDataIndexesExtendedTotalSizeDataPoint(ts, 1, "splunk.index.name-val")
 
+			allMetricsCount++
+			mb.RecordSplunkDatamodelBuildsRunningDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkDispatchArtifactsPendingDeletionDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkDmcInstancesDataPoint(ts, 1, "splunk.server.role-val", "splunk.health.status-val")
+
+			allMetricsCount++
+			mb.RecordSplunkDmcInstancesUnhealthyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkHealthBlockedQueuesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketAvgSizeBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketMaxSizeBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketMergesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketRollsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexBucketUtilizationRatioDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexDaysUntilFullDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexEventsPerSecondDataPoint(ts, 1, "splunk.index.name-val", "splunk.host-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexFrozenArchiveFailuresDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexFrozenArchiveConfiguredDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexHotBucketsCurrentDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexHotBucketsMaxDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexMaxBucketsDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexRetentionUtilizationRatioDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSearchableTestDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSharedGloballyDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSizeColdBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSizeHotBytesDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexSizeWarmBytesDataPoint(ts, 1, "splunk.index.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkIndexerAvgRateDataPoint(ts, 1, "splunk.host-val")
@@ -92,6 +206,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkIndexerCPUTimeDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkIndexerEventsDroppedNoIndexDataPoint(ts, 1, "splunk.index.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexerEventsIndexedDataPoint(ts, 1, "splunk.index.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkIndexerQueueRatioDataPoint(ts, 1, "splunk.host-val")
@@ -100,6 +220,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkIndexerRawWriteTimeDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkIndexerSearchesServedDataPoint(ts, 1, "splunk.host-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIndexerThrottledSecondsDataPoint(ts, 1, "splunk.host-val")
+
 			allMetricsCount++
 			mb.RecordSplunkIndexerThroughputDataPoint(ts, 1, "splunk.indexer.status-val")
 
@@ -119,18 +245,51 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkIndexesMedianDataAgeDataPoint(ts, 1, "splunk.index.name-val")
 
+			allMetricsCount++
+			mb.RecordSplunkIndexesSilentCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkIndexesSizeDataPoint(ts, 1, "splunk.index.name-val")
 
+			allMetricsCount++
+			mb.RecordSplunkIngestionErrorsDataPoint(ts, 1, "splunk.host-val", "splunk.component-val")
+
+			allMetricsCount++
+			mb.RecordSplunkIngestionTruncationsDataPoint(ts, 1, "splunk.sourcetype-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkIoAvgIopsDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkKvstoreCollectionSizeDataPoint(ts, 1, "splunk.app.name-val", "splunk.kvstore.collection-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreDiskUsedBytesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreLookupsActiveDataPoint(ts, 1, "splunk.app.name-val", "splunk.lookup.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreLookupsSlowDataPoint(ts, 1, "splunk.app.name-val", "splunk.lookup.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreMemberUpDataPoint(ts, 1, "splunk.kvstore.member-val", "splunk.kvstore.replication_status-val")
+
+			allMetricsCount++
+			mb.RecordSplunkKvstoreOplogWindowSecondsDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkLicenseIndexUsageDataPoint(ts, 1, "splunk.index.name-val")
 
+			allMetricsCount++
+			mb.RecordSplunkLookupCountDataPoint(ts, 1, "splunk.app.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkLookupSizeBytesDataPoint(ts, 1, "splunk.app.name-val", "splunk.lookup.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkParseQueueRatioDataPoint(ts, 1, "splunk.host-val")
@@ -139,6 +298,37 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkPipelineSetCountDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkQueueEventsPerSecondDataPoint(ts, 1, "splunk.host-val", "splunk.queue.name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverRequestDNSTimeDataPoint(ts, 1, "splunk.endpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverRequestFirstByteTimeDataPoint(ts, 1, "splunk.endpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverRequestTLSHandshakeTimeDataPoint(ts, 1, "splunk.endpoint-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchEventCountDataPoint(ts, 1, "search_name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchFieldStateDataPoint(ts, 1, "search_name-val", "splunk.search.field-val", "splunk.search.field.value-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchResultCountDataPoint(ts, 1, "search_name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchRowsDataPoint(ts, 1, "search_name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchScanCountDataPoint(ts, 1, "search_name-val")
+
+			allMetricsCount++
+			mb.RecordSplunkReceiverSearchTimeoutDataPoint(ts, 1, "search_name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkSchedulerAvgExecutionLatencyDataPoint(ts, 1, "splunk.host-val")
@@ -151,12 +341,44 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSplunkSchedulerCompletionRatioDataPoint(ts, 1, "splunk.host-val")
 
+			allMetricsCount++
+			mb.RecordSplunkSchedulerContinuedCountDataPoint(ts, 1, "splunk.savedsearch-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerDelegatedCountDataPoint(ts, 1, "splunk.host-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerSkippedCountDataPoint(ts, 1, "splunk.host-val", "splunk.scheduler.skip_reason-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSchedulerSkippedTotalDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSplunkScrapeDurationSecondsDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSplunkScrapeSuccessDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesConcurrentDataPoint(ts, 1, "splunk.host-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSearchesLongRunningCountDataPoint(ts, 1, "splunk.user-val")
+
 			allMetricsCount++
 			mb.RecordSplunkServerIntrospectionQueuesCurrentDataPoint(ts, 1, "splunk.queue.name-val")
 
 			allMetricsCount++
 			mb.RecordSplunkServerIntrospectionQueuesCurrentBytesDataPoint(ts, 1, "splunk.queue.name-val")
 
+			allMetricsCount++
+			mb.RecordSplunkServerUptimeSecondsDataPoint(ts, 1, "splunk.host-val")
+
+			allMetricsCount++
+			mb.RecordSplunkSourcetypeEventsDataPoint(ts, 1, "splunk.sourcetype-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSplunkTypingQueueRatioDataPoint(ts, 1, "splunk.host-val")
@@ -198,6 +420,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.host")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.alerts.firing":
+					assert.False(t, validatedMetrics["splunk.alerts.firing"], "Found a duplicate in the metrics slice: splunk.alerts.firing")
+					validatedMetrics["splunk.alerts.firing"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of triggered alerts of each saved search which have not yet expired. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{alerts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch-val", attrVal.Str())
+				case "splunk.alerts.realtime.count":
+					assert.False(t, validatedMetrics["splunk.alerts.realtime.count"], "Found a duplicate in the metrics slice: splunk.alerts.realtime.count")
+					validatedMetrics["splunk.alerts.realtime.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of enabled real-time alerts, by whether they trigger once per search or once per result. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{alerts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.alert.mode")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.alert.mode-val", attrVal.Str())
 				case "splunk.buckets.searchable.status":
 					assert.False(t, validatedMetrics["splunk.buckets.searchable.status"], "Found a duplicate in the metrics slice: splunk.buckets.searchable.status")
 					validatedMetrics["splunk.buckets.searchable.status"] = true
@@ -216,97 +468,97 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("splunk.indexer.searchable")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.indexer.searchable-val", attrVal.Str())
-				case "splunk.data.indexes.extended.bucket.count":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.count")
-					validatedMetrics["splunk.data.indexes.extended.bucket.count"] = true
+				case "splunk.bundle.push.size_bytes":
+					assert.False(t, validatedMetrics["splunk.bundle.push.size_bytes"], "Found a duplicate in the metrics slice: splunk.bundle.push.size_bytes")
+					validatedMetrics["splunk.bundle.push.size_bytes"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Count of buckets per index", ms.At(i).Description())
-					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the size of the latest knowledge bundle the search head pushed to each search peer over the last 10 minutes. Makes imbalanced or oversized bundle pushes visible. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					attrVal, ok := dp.Attributes().Get("splunk.peer")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.data.indexes.extended.bucket.event.count":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.event.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.event.count")
-					validatedMetrics["splunk.data.indexes.extended.bucket.event.count"] = true
+					assert.EqualValues(t, "splunk.peer-val", attrVal.Str())
+				case "splunk.cluster.bucket_sync.lag_seconds":
+					assert.False(t, validatedMetrics["splunk.cluster.bucket_sync.lag_seconds"], "Found a duplicate in the metrics slice: splunk.cluster.bucket_sync.lag_seconds")
+					validatedMetrics["splunk.cluster.bucket_sync.lag_seconds"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Count of events in this bucket super-directory. *Note:** Must be pointed at specific indexer `endpoint`.", ms.At(i).Description())
-					assert.Equal(t, "{events}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking how far behind the bucket synchronization of each peer of the indexer cluster is, as the seconds since the peer last completed replicating a bucket according to its splunkd log over the last 24 hours. *Note:** Search is best run against a Cluster Manager, and is run against the indexer when none is configured.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
+					attrVal, ok := dp.Attributes().Get("splunk.peer")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
-				case "splunk.data.indexes.extended.bucket.hot.count":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.hot.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.hot.count")
-					validatedMetrics["splunk.data.indexes.extended.bucket.hot.count"] = true
+					assert.EqualValues(t, "splunk.peer-val", attrVal.Str())
+				case "splunk.cluster.buckets.replicating":
+					assert.False(t, validatedMetrics["splunk.cluster.buckets.replicating"], "Found a duplicate in the metrics slice: splunk.cluster.buckets.replicating")
+					validatedMetrics["splunk.cluster.buckets.replicating"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "(If size > 0) Number of hot buckets. *Note:** Must be pointed at specific indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "Gauge tracking the number of buckets with a copy currently being replicated to a peer. Every bucket of the cluster is listed to find them, which is costly on large clusters. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
 					assert.Equal(t, "{buckets}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
+				case "splunk.cluster.fixup.oldest_age_seconds":
+					assert.False(t, validatedMetrics["splunk.cluster.fixup.oldest_age_seconds"], "Found a duplicate in the metrics slice: splunk.cluster.fixup.oldest_age_seconds")
+					validatedMetrics["splunk.cluster.fixup.oldest_age_seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long the oldest bucket pending fixup has been waiting per fixup level. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.cluster.fixup.level")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
-				case "splunk.data.indexes.extended.bucket.warm.count":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.warm.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.warm.count")
-					validatedMetrics["splunk.data.indexes.extended.bucket.warm.count"] = true
+					assert.EqualValues(t, "splunk.cluster.fixup.level-val", attrVal.Str())
+				case "splunk.cluster.fixup.pending":
+					assert.False(t, validatedMetrics["splunk.cluster.fixup.pending"], "Found a duplicate in the metrics slice: splunk.cluster.fixup.pending")
+					validatedMetrics["splunk.cluster.fixup.pending"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "(If size > 0) Number of warm buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "Gauge tracking the number of buckets pending fixup in the indexer cluster per fixup level. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
 					assert.Equal(t, "{buckets}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					attrVal, ok := dp.Attributes().Get("splunk.cluster.fixup.level")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
-				case "splunk.data.indexes.extended.event.count":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.event.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.event.count")
-					validatedMetrics["splunk.data.indexes.extended.event.count"] = true
+					assert.EqualValues(t, "splunk.cluster.fixup.level-val", attrVal.Str())
+				case "splunk.cluster.generation_id":
+					assert.False(t, validatedMetrics["splunk.cluster.generation_id"], "Found a duplicate in the metrics slice: splunk.cluster.generation_id")
+					validatedMetrics["splunk.cluster.generation_id"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Count of events for index, excluding frozen events. Approximately equal to the event_count sum of all buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
-					assert.Equal(t, "{events}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the current generation ID of the indexer cluster, incremented by the Cluster Manager whenever the set of primary buckets changes. *Note:** Must be pointed at a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{generation}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.data.indexes.extended.raw.size":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.raw.size"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.raw.size")
-					validatedMetrics["splunk.data.indexes.extended.raw.size"] = true
+				case "splunk.cluster.index.buckets.quarantined":
+					assert.False(t, validatedMetrics["splunk.cluster.index.buckets.quarantined"], "Found a duplicate in the metrics slice: splunk.cluster.index.buckets.quarantined")
+					validatedMetrics["splunk.cluster.index.buckets.quarantined"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Size in bytes on disk of the <bucket>/rawdata/ directories of all buckets in this index, excluding frozen *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
-					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of buckets of each index reported corrupt, for example because of checksum errors, which are a risk to the integrity of its data. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
@@ -315,13 +567,13 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.index.name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.data.indexes.extended.total.size":
-					assert.False(t, validatedMetrics["splunk.data.indexes.extended.total.size"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.total.size")
-					validatedMetrics["splunk.data.indexes.extended.total.size"] = true
+				case "splunk.cluster.index.rf_met_at_ingest":
+					assert.False(t, validatedMetrics["splunk.cluster.index.rf_met_at_ingest"], "Found a duplicate in the metrics slice: splunk.cluster.index.rf_met_at_ingest")
+					validatedMetrics["splunk.cluster.index.rf_met_at_ingest"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Size in bytes on disk of this index *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
-					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, "Gauge reporting whether the replication factor of an index was met as its data was ingested, 1 when it was and 0 when its buckets were written with fewer copies than the replication factor. Unlike the replicated copies ratio, which reflects the cluster once it has caught up, this catches indexes falling behind on replication at ingest time. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
@@ -330,43 +582,597 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.index.name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.indexer.avg.rate":
-					assert.False(t, validatedMetrics["splunk.indexer.avg.rate"], "Found a duplicate in the metrics slice: splunk.indexer.avg.rate")
-					validatedMetrics["splunk.indexer.avg.rate"] = true
+				case "splunk.cluster.indexing_ready":
+					assert.False(t, validatedMetrics["splunk.cluster.indexing_ready"], "Found a duplicate in the metrics slice: splunk.cluster.indexing_ready")
+					validatedMetrics["splunk.cluster.indexing_ready"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the average rate of indexed data. **Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "KBy", ms.At(i).Unit())
+					assert.Equal(t, "Gauge reporting whether the indexer cluster has enough searchable copies to accept data, 1 when ready and 0 otherwise. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.host")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
-				case "splunk.indexer.cpu.time":
-					assert.False(t, validatedMetrics["splunk.indexer.cpu.time"], "Found a duplicate in the metrics slice: splunk.indexer.cpu.time")
-					validatedMetrics["splunk.indexer.cpu.time"] = true
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.maintenance_mode":
+					assert.False(t, validatedMetrics["splunk.cluster.maintenance_mode"], "Found a duplicate in the metrics slice: splunk.cluster.maintenance_mode")
+					validatedMetrics["splunk.cluster.maintenance_mode"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the number of indexing process cpu seconds per instance", ms.At(i).Description())
-					assert.Equal(t, "{s}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge reporting whether the indexer cluster is in maintenance mode, 1 when enabled and 0 otherwise. *Note:** Must be pointed at a cluster master `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.host")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
-				case "splunk.indexer.queue.ratio":
-					assert.False(t, validatedMetrics["splunk.indexer.queue.ratio"], "Found a duplicate in the metrics slice: splunk.indexer.queue.ratio")
-					validatedMetrics["splunk.indexer.queue.ratio"] = true
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.cluster.peer.generation_lag":
+					assert.False(t, validatedMetrics["splunk.cluster.peer.generation_lag"], "Found a duplicate in the metrics slice: splunk.cluster.peer.generation_lag")
+					validatedMetrics["splunk.cluster.peer.generation_lag"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the average indexer index queue ration (%). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "{%}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking how many generations each peer of the indexer cluster is behind the current generation of the Cluster Manager. Peers lagging behind indicate replication problems. *Note:** Must be pointed at a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{generations}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.peer-val", attrVal.Str())
+				case "splunk.cluster.peer_joins":
+					assert.False(t, validatedMetrics["splunk.cluster.peer_joins"], "Found a duplicate in the metrics slice: splunk.cluster.peer_joins")
+					validatedMetrics["splunk.cluster.peer_joins"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times a peer joined the indexer cluster over the last 10 minutes, counted from the `event=addPeer` messages the cluster master logs. A peer frequently joining and leaving is flapping. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{joins}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.peer-val", attrVal.Str())
+				case "splunk.cluster.peer_leaves":
+					assert.False(t, validatedMetrics["splunk.cluster.peer_leaves"], "Found a duplicate in the metrics slice: splunk.cluster.peer_leaves")
+					validatedMetrics["splunk.cluster.peer_leaves"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times a peer left the indexer cluster over the last 10 minutes, counted from the a transition to `Down` messages the cluster master logs. A peer frequently joining and leaving is flapping. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{leaves}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.peer-val", attrVal.Str())
+				case "splunk.data.indexes.extended.bucket.count":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.count")
+					validatedMetrics["splunk.data.indexes.extended.bucket.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Count of buckets per index", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.data.indexes.extended.bucket.event.count":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.event.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.event.count")
+					validatedMetrics["splunk.data.indexes.extended.bucket.event.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Count of events in this bucket super-directory. *Note:** Must be pointed at specific indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
+				case "splunk.data.indexes.extended.bucket.hot.count":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.hot.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.hot.count")
+					validatedMetrics["splunk.data.indexes.extended.bucket.hot.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "(If size > 0) Number of hot buckets. *Note:** Must be pointed at specific indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
+				case "splunk.data.indexes.extended.bucket.warm.count":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.bucket.warm.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.bucket.warm.count")
+					validatedMetrics["splunk.data.indexes.extended.bucket.warm.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "(If size > 0) Number of warm buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.bucket.dir")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.bucket.dir-val", attrVal.Str())
+				case "splunk.data.indexes.extended.event.count":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.event.count"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.event.count")
+					validatedMetrics["splunk.data.indexes.extended.event.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Count of events for index, excluding frozen events. Approximately equal to the event_count sum of all buckets. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.data.indexes.extended.raw.size":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.raw.size"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.raw.size")
+					validatedMetrics["splunk.data.indexes.extended.raw.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Size in bytes on disk of the <bucket>/rawdata/ directories of all buckets in this index, excluding frozen *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.data.indexes.extended.total.size":
+					assert.False(t, validatedMetrics["splunk.data.indexes.extended.total.size"], "Found a duplicate in the metrics slice: splunk.data.indexes.extended.total.size")
+					validatedMetrics["splunk.data.indexes.extended.total.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Size in bytes on disk of this index *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.datamodel.builds.running":
+					assert.False(t, validatedMetrics["splunk.datamodel.builds.running"], "Found a duplicate in the metrics slice: splunk.datamodel.builds.running")
+					validatedMetrics["splunk.datamodel.builds.running"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of data model acceleration summaries currently being built, showing how much of the scheduler capacity acceleration is using. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{builds}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.dispatch.artifacts.pending_deletion":
+					assert.False(t, validatedMetrics["splunk.dispatch.artifacts.pending_deletion"], "Found a duplicate in the metrics slice: splunk.dispatch.artifacts.pending_deletion")
+					validatedMetrics["splunk.dispatch.artifacts.pending_deletion"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of search artifacts in the dispatch directory whose time to live expired and which wait on the dispatch reaper to be deleted. A growing count means cleanup is falling behind. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{artifacts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.dmc.instances":
+					assert.False(t, validatedMetrics["splunk.dmc.instances"], "Found a duplicate in the metrics slice: splunk.dmc.instances")
+					validatedMetrics["splunk.dmc.instances"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of instances of the deployment known to the Monitoring Console per server role and health. Instances with several roles are counted once per role. *Note:** Must be pointed at the search head running the Monitoring Console.", ms.At(i).Description())
+					assert.Equal(t, "{instances}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.server.role")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.server.role-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.health.status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.health.status-val", attrVal.Str())
+				case "splunk.dmc.instances.unhealthy":
+					assert.False(t, validatedMetrics["splunk.dmc.instances.unhealthy"], "Found a duplicate in the metrics slice: splunk.dmc.instances.unhealthy")
+					validatedMetrics["splunk.dmc.instances.unhealthy"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of instances of the deployment the Monitoring Console reports as down or unhealthy. *Note:** Must be pointed at the search head running the Monitoring Console.", ms.At(i).Description())
+					assert.Equal(t, "{instances}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.health.blocked_queues":
+					assert.False(t, validatedMetrics["splunk.health.blocked_queues"], "Found a duplicate in the metrics slice: splunk.health.blocked_queues")
+					validatedMetrics["splunk.health.blocked_queues"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the blocked queues indicator of the splunkd health report, as 0 for green, 1 for yellow and 2 for red. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.index.bucket.avg_size_bytes":
+					assert.False(t, validatedMetrics["splunk.index.bucket.avg_size_bytes"], "Found a duplicate in the metrics slice: splunk.index.bucket.avg_size_bytes")
+					validatedMetrics["splunk.index.bucket.avg_size_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average on-disk size of the buckets of each index, computed with dbinspect. Useful alongside the maximum bucket size for tuning maxDataSize.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.bucket.max_size_bytes":
+					assert.False(t, validatedMetrics["splunk.index.bucket.max_size_bytes"], "Found a duplicate in the metrics slice: splunk.index.bucket.max_size_bytes")
+					validatedMetrics["splunk.index.bucket.max_size_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the on-disk size of the largest bucket of each index, computed with dbinspect.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.bucket_merges":
+					assert.False(t, validatedMetrics["splunk.index.bucket_merges"], "Found a duplicate in the metrics slice: splunk.index.bucket_merges")
+					validatedMetrics["splunk.index.bucket_merges"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of bucket merges per index over the last 10 minutes, counted from the BucketMerger messages in splunkd.log.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.bucket_rolls":
+					assert.False(t, validatedMetrics["splunk.index.bucket_rolls"], "Found a duplicate in the metrics slice: splunk.index.bucket_rolls")
+					validatedMetrics["splunk.index.bucket_rolls"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of hot buckets rolled to warm per index over the last 10 minutes, counted from the HotBucketRoller messages in splunkd.log. A high count can indicate excessive small bucket creation.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.bucket_utilization_ratio":
+					assert.False(t, validatedMetrics["splunk.index.bucket_utilization_ratio"], "Found a duplicate in the metrics slice: splunk.index.bucket_utilization_ratio")
+					validatedMetrics["splunk.index.bucket_utilization_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the ratio of the warm buckets of an index to its maximum number of warm buckets. Nearing 1 warns that buckets are about to roll to cold because of the bucket count limit. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.days_until_full":
+					assert.False(t, validatedMetrics["splunk.index.days_until_full"], "Found a duplicate in the metrics slice: splunk.index.days_until_full")
+					validatedMetrics["splunk.index.days_until_full"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the projected number of days until an index reaches its maxTotalDataSizeMB, based on the growth between two scrapes. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{days}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.events_per_second":
+					assert.False(t, validatedMetrics["splunk.index.events_per_second"], "Found a duplicate in the metrics slice: splunk.index.events_per_second")
+					validatedMetrics["splunk.index.events_per_second"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average number of events per second each indexer indexed into each index over the last 10 minutes, from the per_index_thruput metrics of metrics.log. Use it to pinpoint the index and host combinations taking the most events. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{events}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.index.frozen.archive_failures":
+					assert.False(t, validatedMetrics["splunk.index.frozen.archive_failures"], "Found a duplicate in the metrics slice: splunk.index.frozen.archive_failures")
+					validatedMetrics["splunk.index.frozen.archive_failures"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of errors logged over the last 10 minutes while archiving the buckets of each index as they roll to frozen. A failing `coldToFrozenScript` otherwise loses data silently. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{errors}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.frozen_archive_configured":
+					assert.False(t, validatedMetrics["splunk.index.frozen_archive_configured"], "Found a duplicate in the metrics slice: splunk.index.frozen_archive_configured")
+					validatedMetrics["splunk.index.frozen_archive_configured"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge reporting whether an index archives buckets as they roll to frozen, 1 when a `coldToFrozenDir` or `coldToFrozenScript` is configured and 0 when frozen buckets are deleted.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.hot_buckets.current":
+					assert.False(t, validatedMetrics["splunk.index.hot_buckets.current"], "Found a duplicate in the metrics slice: splunk.index.hot_buckets.current")
+					validatedMetrics["splunk.index.hot_buckets.current"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of hot buckets open for an index. Once an index reaches its `maxHotBuckets` every new bucket rolls an existing one to warm, and indexing into it stalls when buckets cannot roll fast enough. *Note:** Must be pointed at an indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.hot_buckets.max":
+					assert.False(t, validatedMetrics["splunk.index.hot_buckets.max"], "Found a duplicate in the metrics slice: splunk.index.hot_buckets.max")
+					validatedMetrics["splunk.index.hot_buckets.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the maximum number of hot buckets an index may have open at once, its `maxHotBuckets` setting, with `auto` and `auto_high_volume` reported as the 3 and 10 buckets they stand for. Compare with `splunk.index.hot_buckets.current` to alert before an index runs out of hot buckets. *Note:** Must be pointed at an indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.max_buckets":
+					assert.False(t, validatedMetrics["splunk.index.max_buckets"], "Found a duplicate in the metrics slice: splunk.index.max_buckets")
+					validatedMetrics["splunk.index.max_buckets"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the maximum number of warm buckets (maxWarmDBCount) an index may hold before its oldest warm buckets roll to cold. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{buckets}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.retention_utilization_ratio":
+					assert.False(t, validatedMetrics["splunk.index.retention_utilization_ratio"], "Found a duplicate in the metrics slice: splunk.index.retention_utilization_ratio")
+					validatedMetrics["splunk.index.retention_utilization_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the median age of the data of each index as a fraction of its frozenTimePeriodInSecs. Data is rolled to frozen as it reaches a ratio of 1. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.searchable_test":
+					assert.False(t, validatedMetrics["splunk.index.searchable_test"], "Found a duplicate in the metrics slice: splunk.index.searchable_test")
+					validatedMetrics["splunk.index.searchable_test"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge reporting whether a test search against the index returned any events, 1 when it did and 0 otherwise. Catches permission and configuration issues that bucket metrics miss. Only reported for the indexes listed in `searchable_test_indexes`. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.shared_globally":
+					assert.False(t, validatedMetrics["splunk.index.shared_globally"], "Found a duplicate in the metrics slice: splunk.index.shared_globally")
+					validatedMetrics["splunk.index.shared_globally"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge reporting whether an index is shared globally, making it visible from every app, 1 when its sharing is `global` and 0 otherwise.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.size.cold_bytes":
+					assert.False(t, validatedMetrics["splunk.index.size.cold_bytes"], "Found a duplicate in the metrics slice: splunk.index.size.cold_bytes")
+					validatedMetrics["splunk.index.size.cold_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size in bytes on disk of its cold buckets, the buckets moved to the cold path, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.size.hot_bytes":
+					assert.False(t, validatedMetrics["splunk.index.size.hot_bytes"], "Found a duplicate in the metrics slice: splunk.index.size.hot_bytes")
+					validatedMetrics["splunk.index.size.hot_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size in bytes on disk of its hot buckets, the buckets open for writing, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.index.size.warm_bytes":
+					assert.False(t, validatedMetrics["splunk.index.size.warm_bytes"], "Found a duplicate in the metrics slice: splunk.index.size.warm_bytes")
+					validatedMetrics["splunk.index.size.warm_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size in bytes on disk of its warm buckets, the rolled buckets remaining on the home path, per index. Compare the sizes of the bucket states to plan the storage tiers of an index. *Note:** Must be pointed at an indexer `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexer.avg.rate":
+					assert.False(t, validatedMetrics["splunk.indexer.avg.rate"], "Found a duplicate in the metrics slice: splunk.indexer.avg.rate")
+					validatedMetrics["splunk.indexer.avg.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average rate of indexed data. **Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "KBy", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.indexer.cpu.time":
+					assert.False(t, validatedMetrics["splunk.indexer.cpu.time"], "Found a duplicate in the metrics slice: splunk.indexer.cpu.time")
+					validatedMetrics["splunk.indexer.cpu.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of indexing process cpu seconds per instance", ms.At(i).Description())
+					assert.Equal(t, "{s}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.indexer.events_dropped_no_index":
+					assert.False(t, validatedMetrics["splunk.indexer.events_dropped_no_index"], "Found a duplicate in the metrics slice: splunk.indexer.events_dropped_no_index")
+					validatedMetrics["splunk.indexer.events_dropped_no_index"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events dropped over the last 10 minutes because they were routed to an index which is not configured, disabled or deleted, by the name of the missing index. Counted from the warnings the IndexProcessor logs for each such event. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexer.events_indexed":
+					assert.False(t, validatedMetrics["splunk.indexer.events_indexed"], "Found a duplicate in the metrics slice: splunk.indexer.events_indexed")
+					validatedMetrics["splunk.indexer.events_indexed"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Cumulative count of events indexed per index, reported as a monotonic sum so that rates can be derived downstream. Events rolled to frozen are no longer counted, which shows up as a counter reset. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexer.queue.ratio":
+					assert.False(t, validatedMetrics["splunk.indexer.queue.ratio"], "Found a duplicate in the metrics slice: splunk.indexer.queue.ratio")
+					validatedMetrics["splunk.indexer.queue.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average indexer index queue ration (%). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{%}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
@@ -389,127 +1195,331 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, float64(1), dp.DoubleValue())
 					attrVal, ok := dp.Attributes().Get("splunk.host")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
-				case "splunk.indexer.throughput":
-					assert.False(t, validatedMetrics["splunk.indexer.throughput"], "Found a duplicate in the metrics slice: splunk.indexer.throughput")
-					validatedMetrics["splunk.indexer.throughput"] = true
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.indexer.searches_served":
+					assert.False(t, validatedMetrics["splunk.indexer.searches_served"], "Found a duplicate in the metrics slice: splunk.indexer.searches_served")
+					validatedMetrics["splunk.indexer.searches_served"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches dispatched to each search peer by search heads over the last 10 minutes, revealing search load imbalance across indexers. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.indexer.throttled_seconds":
+					assert.False(t, validatedMetrics["splunk.indexer.throttled_seconds"], "Found a duplicate in the metrics slice: splunk.indexer.throttled_seconds")
+					validatedMetrics["splunk.indexer.throttled_seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of seconds indexing was throttled on each host over the last 10 minutes, measured as the time its index queue reported being blocked in metrics.log.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.indexer.throughput":
+					assert.False(t, validatedMetrics["splunk.indexer.throughput"], "Found a duplicate in the metrics slice: splunk.indexer.throughput")
+					validatedMetrics["splunk.indexer.throughput"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking average bytes per second throughput of indexer. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "By/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.indexer.status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.indexer.status-val", attrVal.Str())
+				case "splunk.indexes.avg.size":
+					assert.False(t, validatedMetrics["splunk.indexes.avg.size"], "Found a duplicate in the metrics slice: splunk.indexes.avg.size")
+					validatedMetrics["splunk.indexes.avg.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexes and their average size (gb). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "Gb", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexes.avg.usage":
+					assert.False(t, validatedMetrics["splunk.indexes.avg.usage"], "Found a duplicate in the metrics slice: splunk.indexes.avg.usage")
+					validatedMetrics["splunk.indexes.avg.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexes and their average usage (%). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{%}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexes.bucket.count":
+					assert.False(t, validatedMetrics["splunk.indexes.bucket.count"], "Found a duplicate in the metrics slice: splunk.indexes.bucket.count")
+					validatedMetrics["splunk.indexes.bucket.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexes and their bucket counts. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{count}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexes.median.data.age":
+					assert.False(t, validatedMetrics["splunk.indexes.median.data.age"], "Found a duplicate in the metrics slice: splunk.indexes.median.data.age")
+					validatedMetrics["splunk.indexes.median.data.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexes and their median data age (days). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{days}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.indexes.silent.count":
+					assert.False(t, validatedMetrics["splunk.indexes.silent.count"], "Found a duplicate in the metrics slice: splunk.indexes.silent.count")
+					validatedMetrics["splunk.indexes.silent.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of indexes which have not received data within `silent_index_threshold`. Indexes which never received any data are not counted. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
+					assert.Equal(t, "{indexes}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.indexes.size":
+					assert.False(t, validatedMetrics["splunk.indexes.size"], "Found a duplicate in the metrics slice: splunk.indexes.size")
+					validatedMetrics["splunk.indexes.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the indexes and their total size (gb). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "Gb", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+				case "splunk.ingestion.errors":
+					assert.False(t, validatedMetrics["splunk.ingestion.errors"], "Found a duplicate in the metrics slice: splunk.ingestion.errors")
+					validatedMetrics["splunk.ingestion.errors"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of errors logged by ingestion pipeline processors over the last 10 minutes. Errors here typically mean dropped or truncated events.", ms.At(i).Description())
+					assert.Equal(t, "{errors}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.component")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.component-val", attrVal.Str())
+				case "splunk.ingestion.truncations":
+					assert.False(t, validatedMetrics["splunk.ingestion.truncations"], "Found a duplicate in the metrics slice: splunk.ingestion.truncations")
+					validatedMetrics["splunk.ingestion.truncations"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events truncated over the last 10 minutes for exceeding the `TRUNCATE` limit of their sourcetype, by sourcetype. Counted from the warnings the LineBreakingProcessor logs for each truncated line. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.sourcetype")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.sourcetype-val", attrVal.Str())
+				case "splunk.io.avg.iops":
+					assert.False(t, validatedMetrics["splunk.io.avg.iops"], "Found a duplicate in the metrics slice: splunk.io.avg.iops")
+					validatedMetrics["splunk.io.avg.iops"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average IOPs used per instance", ms.At(i).Description())
+					assert.Equal(t, "{iops}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.kvstore.collection.size":
+					assert.False(t, validatedMetrics["splunk.kvstore.collection.size"], "Found a duplicate in the metrics slice: splunk.kvstore.collection.size")
+					validatedMetrics["splunk.kvstore.collection.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the size of the documents of each KV store collection, as reported by the collection statistics introspection of the KV store. *Note:** Must be pointed at a search head.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.kvstore.collection")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.collection-val", attrVal.Str())
+				case "splunk.kvstore.disk_used_bytes":
+					assert.False(t, validatedMetrics["splunk.kvstore.disk_used_bytes"], "Found a duplicate in the metrics slice: splunk.kvstore.disk_used_bytes")
+					validatedMetrics["splunk.kvstore.disk_used_bytes"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking average bytes per second throughput of indexer. *Note:** Must be pointed at specific indexer `endpoint` and gathers metrics from only that indexer.", ms.At(i).Description())
-					assert.Equal(t, "By/s", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the disk space used by the KV store. *Note:** Must be pointed at a search head.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.indexer.status")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.indexer.status-val", attrVal.Str())
-				case "splunk.indexes.avg.size":
-					assert.False(t, validatedMetrics["splunk.indexes.avg.size"], "Found a duplicate in the metrics slice: splunk.indexes.avg.size")
-					validatedMetrics["splunk.indexes.avg.size"] = true
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.kvstore.lookups.active":
+					assert.False(t, validatedMetrics["splunk.kvstore.lookups.active"], "Found a duplicate in the metrics slice: splunk.kvstore.lookups.active")
+					validatedMetrics["splunk.kvstore.lookups.active"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexes and their average size (gb). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "Gb", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of lookups run against a KV store collection over the last 10 minutes, as recorded by the profiling introspection of the KV store. Correlate with slow searches to spot collections under pressure. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{lookups}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.indexes.avg.usage":
-					assert.False(t, validatedMetrics["splunk.indexes.avg.usage"], "Found a duplicate in the metrics slice: splunk.indexes.avg.usage")
-					validatedMetrics["splunk.indexes.avg.usage"] = true
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.lookup.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.lookup.name-val", attrVal.Str())
+				case "splunk.kvstore.lookups.slow":
+					assert.False(t, validatedMetrics["splunk.kvstore.lookups.slow"], "Found a duplicate in the metrics slice: splunk.kvstore.lookups.slow")
+					validatedMetrics["splunk.kvstore.lookups.slow"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexes and their average usage (%). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "{%}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of lookups run against a KV store collection over the last 10 minutes which took longer than 100ms, as recorded by the profiling introspection of the KV store. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{lookups}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.indexes.bucket.count":
-					assert.False(t, validatedMetrics["splunk.indexes.bucket.count"], "Found a duplicate in the metrics slice: splunk.indexes.bucket.count")
-					validatedMetrics["splunk.indexes.bucket.count"] = true
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.lookup.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.lookup.name-val", attrVal.Str())
+				case "splunk.kvstore.member.up":
+					assert.False(t, validatedMetrics["splunk.kvstore.member.up"], "Found a duplicate in the metrics slice: splunk.kvstore.member.up")
+					validatedMetrics["splunk.kvstore.member.up"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexes and their bucket counts. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "{count}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking whether each member of the KV store replica set is up, 1 when it is the captain or a replicating member and 0 otherwise. A standalone KV store is reported as its only member, up when it is ready. *Note:** Must be pointed at a search head.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					attrVal, ok := dp.Attributes().Get("splunk.kvstore.member")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.indexes.median.data.age":
-					assert.False(t, validatedMetrics["splunk.indexes.median.data.age"], "Found a duplicate in the metrics slice: splunk.indexes.median.data.age")
-					validatedMetrics["splunk.indexes.median.data.age"] = true
+					assert.EqualValues(t, "splunk.kvstore.member-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.kvstore.replication_status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.kvstore.replication_status-val", attrVal.Str())
+				case "splunk.kvstore.oplog.window_seconds":
+					assert.False(t, validatedMetrics["splunk.kvstore.oplog.window_seconds"], "Found a duplicate in the metrics slice: splunk.kvstore.oplog.window_seconds")
+					validatedMetrics["splunk.kvstore.oplog.window_seconds"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexes and their median data age (days). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "{days}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the time span covered by the oplog of the KV store. Members falling further behind than this window can no longer replicate and need a full resync. *Note:** Must be pointed at a search head.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
-					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.indexes.size":
-					assert.False(t, validatedMetrics["splunk.indexes.size"], "Found a duplicate in the metrics slice: splunk.indexes.size")
-					validatedMetrics["splunk.indexes.size"] = true
+				case "splunk.license.index.usage":
+					assert.False(t, validatedMetrics["splunk.license.index.usage"], "Found a duplicate in the metrics slice: splunk.license.index.usage")
+					validatedMetrics["splunk.license.index.usage"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexes and their total size (gb). *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
-					assert.Equal(t, "Gb", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the indexed license usage per index", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
-					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
-					assert.Equal(t, float64(1), dp.DoubleValue())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 					attrVal, ok := dp.Attributes().Get("splunk.index.name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
-				case "splunk.io.avg.iops":
-					assert.False(t, validatedMetrics["splunk.io.avg.iops"], "Found a duplicate in the metrics slice: splunk.io.avg.iops")
-					validatedMetrics["splunk.io.avg.iops"] = true
+				case "splunk.lookup.count":
+					assert.False(t, validatedMetrics["splunk.lookup.count"], "Found a duplicate in the metrics slice: splunk.lookup.count")
+					validatedMetrics["splunk.lookup.count"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the average IOPs used per instance", ms.At(i).Description())
-					assert.Equal(t, "{iops}", ms.At(i).Unit())
+					assert.Equal(t, "Gauge tracking the number of lookup table files and KV store collections per app. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{lookups}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.host")
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
-				case "splunk.license.index.usage":
-					assert.False(t, validatedMetrics["splunk.license.index.usage"], "Found a duplicate in the metrics slice: splunk.license.index.usage")
-					validatedMetrics["splunk.license.index.usage"] = true
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+				case "splunk.lookup.size_bytes":
+					assert.False(t, validatedMetrics["splunk.lookup.size_bytes"], "Found a duplicate in the metrics slice: splunk.lookup.size_bytes")
+					validatedMetrics["splunk.lookup.size_bytes"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Gauge tracking the indexed license usage per index", ms.At(i).Description())
+					assert.Equal(t, "Gauge tracking the size of each KV store collection. Lookup table files are not included as their size is not reported by the REST API. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
 					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-					attrVal, ok := dp.Attributes().Get("splunk.index.name")
+					attrVal, ok := dp.Attributes().Get("splunk.app.name")
 					assert.True(t, ok)
-					assert.EqualValues(t, "splunk.index.name-val", attrVal.Str())
+					assert.EqualValues(t, "splunk.app.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.lookup.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.lookup.name-val", attrVal.Str())
 				case "splunk.parse.queue.ratio":
 					assert.False(t, validatedMetrics["splunk.parse.queue.ratio"], "Found a duplicate in the metrics slice: splunk.parse.queue.ratio")
 					validatedMetrics["splunk.parse.queue.ratio"] = true
@@ -540,6 +1550,167 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.host")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.queue.events_per_second":
+					assert.False(t, validatedMetrics["splunk.queue.events_per_second"], "Found a duplicate in the metrics slice: splunk.queue.events_per_second")
+					validatedMetrics["splunk.queue.events_per_second"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the average number of events per second read from each pipeline queue over the last 10 minutes, complementing the fill ratios of the queues.", ms.At(i).Description())
+					assert.Equal(t, "{events}/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.queue.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.queue.name-val", attrVal.Str())
+				case "splunk.receiver.request.dns.time":
+					assert.False(t, validatedMetrics["splunk.receiver.request.dns.time"], "Found a duplicate in the metrics slice: splunk.receiver.request.dns.time")
+					validatedMetrics["splunk.receiver.request.dns.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the longest time the DNS lookup for a connection to an endpoint took during the scrape. Requests reusing a connection, or to an endpoint configured by IP address, do not look it up.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.endpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.endpoint-val", attrVal.Str())
+				case "splunk.receiver.request.first_byte.time":
+					assert.False(t, validatedMetrics["splunk.receiver.request.first_byte.time"], "Found a duplicate in the metrics slice: splunk.receiver.request.first_byte.time")
+					validatedMetrics["splunk.receiver.request.first_byte.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the longest time an endpoint took during the scrape to start responding once a request was written, which is the time Splunk spends on the request rather than on the network.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.endpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.endpoint-val", attrVal.Str())
+				case "splunk.receiver.request.tls_handshake.time":
+					assert.False(t, validatedMetrics["splunk.receiver.request.tls_handshake.time"], "Found a duplicate in the metrics slice: splunk.receiver.request.tls_handshake.time")
+					validatedMetrics["splunk.receiver.request.tls_handshake.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the longest time the TLS handshake of a connection to an endpoint took during the scrape. Requests reusing a connection do not perform one.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("splunk.endpoint")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.endpoint-val", attrVal.Str())
+				case "splunk.receiver.search.event_count":
+					assert.False(t, validatedMetrics["splunk.receiver.search.event_count"], "Found a duplicate in the metrics slice: splunk.receiver.search.event_count")
+					validatedMetrics["splunk.receiver.search.event_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events returned by a search run by the receiver. Requires an additional request per search.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
+				case "splunk.receiver.search.field.state":
+					assert.False(t, validatedMetrics["splunk.receiver.search.field.state"], "Found a duplicate in the metrics slice: splunk.receiver.search.field.state")
+					validatedMetrics["splunk.receiver.search.field.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge reporting the integer that `state_fields` maps a string result field value of a search to, with the string value as an attribute. Only reported for the searches and fields configured in `state_fields`.", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.search.field")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.field-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.search.field.value")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.search.field.value-val", attrVal.Str())
+				case "splunk.receiver.search.result_count":
+					assert.False(t, validatedMetrics["splunk.receiver.search.result_count"], "Found a duplicate in the metrics slice: splunk.receiver.search.result_count")
+					validatedMetrics["splunk.receiver.search.result_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of results produced by a search run by the receiver. Requires an additional request per search.", ms.At(i).Description())
+					assert.Equal(t, "{results}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
+				case "splunk.receiver.search.rows":
+					assert.False(t, validatedMetrics["splunk.receiver.search.rows"], "Found a duplicate in the metrics slice: splunk.receiver.search.rows")
+					validatedMetrics["splunk.receiver.search.rows"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of result rows the receiver parsed from a search it ran.", ms.At(i).Description())
+					assert.Equal(t, "{rows}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
+				case "splunk.receiver.search.scan_count":
+					assert.False(t, validatedMetrics["splunk.receiver.search.scan_count"], "Found a duplicate in the metrics slice: splunk.receiver.search.scan_count")
+					validatedMetrics["splunk.receiver.search.scan_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events scanned by a search run by the receiver. Requires an additional request per search.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
+				case "splunk.receiver.search.timeout":
+					assert.False(t, validatedMetrics["splunk.receiver.search.timeout"], "Found a duplicate in the metrics slice: splunk.receiver.search.timeout")
+					validatedMetrics["splunk.receiver.search.timeout"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times a search run by the receiver did not complete within the scrape timeout since the receiver started.", ms.At(i).Description())
+					assert.Equal(t, "{timeouts}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("search_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "search_name-val", attrVal.Str())
 				case "splunk.scheduler.avg.execution.latency":
 					assert.False(t, validatedMetrics["splunk.scheduler.avg.execution.latency"], "Found a duplicate in the metrics slice: splunk.scheduler.avg.execution.latency")
 					validatedMetrics["splunk.scheduler.avg.execution.latency"] = true
@@ -585,6 +1756,120 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.host")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.scheduler.continued.count":
+					assert.False(t, validatedMetrics["splunk.scheduler.continued.count"], "Found a duplicate in the metrics slice: splunk.scheduler.continued.count")
+					validatedMetrics["splunk.scheduler.continued.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of times each scheduled search was continued over the last 10 minutes because its previous run had not completed. Continued searches run longer than their schedule interval and can cascade into skipped searches.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.savedsearch")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.savedsearch-val", attrVal.Str())
+				case "splunk.scheduler.delegated.count":
+					assert.False(t, validatedMetrics["splunk.scheduler.delegated.count"], "Found a duplicate in the metrics slice: splunk.scheduler.delegated.count")
+					validatedMetrics["splunk.scheduler.delegated.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of scheduled searches the search head cluster captain delegated to each member over the last 10 minutes. Useful to spot an uneven distribution of the scheduled search load. *Note:** Must be pointed at a cluster master `endpoint` with access to the search head cluster's internal logs.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.scheduler.skipped.count":
+					assert.False(t, validatedMetrics["splunk.scheduler.skipped.count"], "Found a duplicate in the metrics slice: splunk.scheduler.skipped.count")
+					validatedMetrics["splunk.scheduler.skipped.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of scheduled searches skipped over the last 10 minutes by each host, by the reason they were skipped for. Skips for reaching the maximum number of concurrent searches are a sign of scheduler saturation. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("splunk.scheduler.skip_reason")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.scheduler.skip_reason-val", attrVal.Str())
+				case "splunk.scheduler.skipped.total":
+					assert.False(t, validatedMetrics["splunk.scheduler.skipped.total"], "Found a duplicate in the metrics slice: splunk.scheduler.skipped.total")
+					validatedMetrics["splunk.scheduler.skipped.total"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of scheduled searches skipped over the last 10 minutes across all hosts and searches, as a single signal to alert on. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.scrape.duration_seconds":
+					assert.False(t, validatedMetrics["splunk.scrape.duration_seconds"], "Found a duplicate in the metrics slice: splunk.scrape.duration_seconds")
+					validatedMetrics["splunk.scrape.duration_seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking how long the scrape of all the enabled metrics took, like the `scrape_duration_seconds` of Prometheus.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "splunk.scrape.success":
+					assert.False(t, validatedMetrics["splunk.scrape.success"], "Found a duplicate in the metrics slice: splunk.scrape.success")
+					validatedMetrics["splunk.scrape.success"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge reporting whether the scrape succeeded, 1 when it did and 0 when it failed, like the `up` metric of Prometheus. A scrape failing for some metrics only, such as one cut short by `max_scrape_duration`, still counts as a success. When the scrape fails, the other metrics are not emitted.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "splunk.searches.concurrent":
+					assert.False(t, validatedMetrics["splunk.searches.concurrent"], "Found a duplicate in the metrics slice: splunk.searches.concurrent")
+					validatedMetrics["splunk.searches.concurrent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches currently running on the search head, counted from the resource usage of its search processes. Use it to watch search load against the concurrency limits. *Note:** Must be pointed at a search head `endpoint`.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.searches.long_running.count":
+					assert.False(t, validatedMetrics["splunk.searches.long_running.count"], "Found a duplicate in the metrics slice: splunk.searches.long_running.count")
+					validatedMetrics["splunk.searches.long_running.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of searches which completed over the last 10 minutes after running for longer than the `LongRunningSearchSeconds` search variable, by the user who ran them. Counted from the audit trail. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{searches}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.user")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.user-val", attrVal.Str())
 				case "splunk.server.introspection.queues.current":
 					assert.False(t, validatedMetrics["splunk.server.introspection.queues.current"], "Found a duplicate in the metrics slice: splunk.server.introspection.queues.current")
 					validatedMetrics["splunk.server.introspection.queues.current"] = true
@@ -615,6 +1900,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("splunk.queue.name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "splunk.queue.name-val", attrVal.Str())
+				case "splunk.server.uptime_seconds":
+					assert.False(t, validatedMetrics["splunk.server.uptime_seconds"], "Found a duplicate in the metrics slice: splunk.server.uptime_seconds")
+					validatedMetrics["splunk.server.uptime_seconds"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of seconds since splunkd last started on each configured Splunk instance, for correlating restarts with changes in other metrics.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.host")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.host-val", attrVal.Str())
+				case "splunk.sourcetype.events":
+					assert.False(t, validatedMetrics["splunk.sourcetype.events"], "Found a duplicate in the metrics slice: splunk.sourcetype.events")
+					validatedMetrics["splunk.sourcetype.events"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Gauge tracking the number of events indexed over the last 10 minutes for each sourcetype, summed across the indexers as reported by the `metadata` command. Use it to see which sourcetypes dominate ingestion. *Note:** Search is best run against a Cluster Manager.", ms.At(i).Description())
+					assert.Equal(t, "{events}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("splunk.sourcetype")
+					assert.True(t, ok)
+					assert.EqualValues(t, "splunk.sourcetype-val", attrVal.Str())
 				case "splunk.typing.queue.ratio":
 					assert.False(t, validatedMetrics["splunk.typing.queue.ratio"], "Found a duplicate in the metrics slice: splunk.typing.queue.ratio")
 					validatedMetrics["splunk.typing.queue.ratio"] = true
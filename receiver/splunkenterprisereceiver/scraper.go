@@ -10,1045 +10,3560 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
 )
 
 var (
 	errMaxSearchWaitTimeExceeded = errors.New("maximum search wait time exceeded for metric")
+	errMaxScrapeDurationExceeded = errors.New("maximum scrape duration exceeded")
+	errUnknownScrapeGroup        = errors.New("unknown scrape group")
 )
 
 type splunkScraper struct {
 	splunkClient *splunkEntClient
 	settings     component.TelemetrySettings
+	params       receiver.CreateSettings
 	conf         *Config
 	mb           *metadata.MetricsBuilder
+	// the start time of the cumulative metrics, set when the receiver starts
+	startTime pcommon.Timestamp
+	// index sizes seen on the previous scrape, used to estimate how fast each index is growing
+	indexSizes map[string]indexSizeSample
+	// bounds the number of outstanding search jobs per endpoint type, empty when no limit is configured
+	searchSems map[string]chan struct{}
+	// number of times each search has timed out since the receiver started
+	searchTimeouts map[string]int64
+	// indexes returned by the index discovery search, nil until it has run successfully
+	discoveredIndexes map[string]bool
+	// when the index discovery search last ran successfully
+	discoveredAt time.Time
+	// set for the duration of a scrape when the search head is a member of a search head cluster other than
+	// its captain and only the captain is to report metrics of the cluster as a whole
+	skipClusterScope bool
+	// serializes scrapes, which may be triggered on demand through scrapeGroup
+	scrapeMu *sync.Mutex
+	// guards the state the scrape functions share when they run concurrently
+	stateMu *sync.Mutex
+	// the server/info responses of each endpoint type, fetched at most once per scrape
+	serverInfos map[string]*serverInfoResponse
+	// the configuration of every index as last fetched in full
+	indexConfig *indexConfigCache
+	// the indexes-extended listing of the indexer, fetched at most once per scrape
+	indexesExtendedRes *indexesExtendedResponse
+	// the server roles of each endpoint type as fetched on start, missing when they could not be fetched
+	serverRoles map[string][]string
+	// records the requests of each scrape to be written to the diagnostics directory, nil unless configured
+	diagnostics *diagnosticsRecorder
+	// traces the connection phases of the requests of each scrape, nil unless their metrics are enabled
+	connections *connectionTracer
+	// metrics whose unit override was found not to be convertible, so that it is only logged once
+	unconvertedUnits map[string]bool
+	// draws the metrics with a sampling probability which are collected on each collection interval
+	sampler *rand.Rand
+}
+
+// A successful server/info response, kept as is since some callers only need its Date header
+type serverInfoResponse struct {
+	date string
+	body []byte
+}
+
+// The outcome of fetching the indexes-extended listing, failures included so that they are not retried by
+// every metric read from it
+type indexesExtendedResponse struct {
+	once    sync.Once
+	indexes *IndexesExtended
+	err     error
+}
+
+// The configuration of every index as last fetched in full, and when it was
+type indexConfigCache struct {
+	entries   []IndexesEntry
+	fetchedAt time.Time
+}
+
+// Server roles as reported by services/server/info
+const (
+	roleIndexer        = "indexer"
+	roleClusterManager = "cluster_master"
+)
+
+// The role required of the instance behind each endpoint type by the scrapes of APIs only it serves
+var endpointRoles = map[string]string{
+	typeIdx: roleIndexer,
+	typeCm:  roleClusterManager,
+}
+
+// A scrape function tagged with the name of the group of metrics it records
+type groupedScrape struct {
+	group  string
+	scrape scrapeFunc
+}
+
+// A scrape function as a method expression, so that it can be run against a copy of the scraper
+type scrapeFunc func(*splunkScraper, context.Context, pcommon.Timestamp, *scrapererror.ScrapeErrors)
+
+// The size of an index at a point in time
+type indexSizeSample struct {
+	sizeMB float64
+	ts     time.Time
 }
 
 func newSplunkMetricsScraper(params receiver.CreateSettings, cfg *Config) splunkScraper {
+	searchSems := make(map[string]chan struct{})
+	if cfg.MaxConcurrentSearches > 0 {
+		for t, e := range map[string]string{typeIdx: cfg.IdxEndpoint.Endpoint, typeSh: cfg.SHEndpoint.Endpoint, typeCm: cfg.CMEndpoint.Endpoint} {
+			if e != "" {
+				searchSems[t] = sharedSearchSem(e, cfg.MaxConcurrentSearches)
+			}
+		}
+	}
+
+	var diagnostics *diagnosticsRecorder
+	if cfg.DiagnosticsDir != "" {
+		diagnostics = &diagnosticsRecorder{}
+	}
+
+	var connections *connectionTracer
+	if m := cfg.MetricsBuilderConfig.Metrics; m.SplunkReceiverRequestDNSTime.Enabled || m.SplunkReceiverRequestTLSHandshakeTime.Enabled ||
+		m.SplunkReceiverRequestFirstByteTime.Enabled {
+		connections = &connectionTracer{}
+	}
+
 	return splunkScraper{
-		settings: params.TelemetrySettings,
-		conf:     cfg,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		settings:           params.TelemetrySettings,
+		params:             params,
+		conf:               cfg,
+		mb:                 metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		indexSizes:         make(map[string]indexSizeSample),
+		searchSems:         searchSems,
+		searchTimeouts:     make(map[string]int64),
+		scrapeMu:           &sync.Mutex{},
+		stateMu:            &sync.Mutex{},
+		serverInfos:        make(map[string]*serverInfoResponse),
+		indexConfig:        &indexConfigCache{},
+		indexesExtendedRes: &indexesExtendedResponse{},
+		serverRoles:        make(map[string][]string),
+		diagnostics:        diagnostics,
+		connections:        connections,
+		unconvertedUnits:   make(map[string]bool),
+		sampler:            rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec // no need for a secure random number
 	}
 }
 
 // Create a client instance and add to the splunkScraper
-func (s *splunkScraper) start(_ context.Context, h component.Host) (err error) {
-	client, err := newSplunkEntClient(s.conf, h, s.settings)
+func (s *splunkScraper) start(ctx context.Context, h component.Host) (err error) {
+	var wrappers []transportWrapper
+	if s.diagnostics != nil {
+		if err = os.MkdirAll(s.conf.DiagnosticsDir, 0o700); err != nil {
+			return err
+		}
+		wrappers = append(wrappers, s.diagnostics.wrap)
+	}
+	if s.connections != nil {
+		wrappers = append(wrappers, s.connections.wrap)
+	}
+
+	client, err := newSplunkEntClient(s.conf, h, s.settings, wrappers...)
 	if err != nil {
 		return err
 	}
 	s.splunkClient = client
+	// cumulative metrics count from the moment the receiver starts, which every data point reports as its
+	// start time for as long as the receiver runs
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+	s.mb.Reset(metadata.WithStartTime(s.startTime))
+
+	// endpoints which cannot be logged in to now log in again once their requests are rejected
+	if err = s.splunkClient.login(ctx); err != nil {
+		s.settings.Logger.Warn("failed to log in to splunk", zap.Error(err))
+	}
+
+	if s.conf.WarmupSearch != "" {
+		s.warmup(ctx)
+	}
+
+	s.fetchServerRoles(ctx)
+
+	// indexes which cannot be discovered now are discovered on the first scrape instead
+	if s.conf.IndexDiscoverySearch != "" {
+		if err = s.discoverIndexes(ctx); err != nil {
+			s.settings.Logger.Warn("failed to discover the indexes to scrape", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Dispatches the warmup search to each configured endpoint. The job keeps running on the Splunk side so
+// only its dispatch is waited for. A failure merely leaves the first scrape slower, so it is only logged.
+func (s *splunkScraper) warmup(ctx context.Context) {
+	if s.conf.ScraperControllerSettings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.conf.ScraperControllerSettings.Timeout)
+		defer cancel()
+	}
+
+	for _, ept := range []string{typeIdx, typeSh, typeCm} {
+		if !s.splunkClient.isConfigured(ept) {
+			continue
+		}
+
+		sr := searchResponse{
+			search: url.Values{"search": {s.conf.WarmupSearch}}.Encode(),
+		}
+		req, err := s.splunkClient.createRequest(context.WithValue(ctx, endpointType("type"), ept), &sr)
+		if err != nil {
+			s.settings.Logger.Warn("failed to dispatch the warmup search", zap.String("endpoint", ept), zap.Error(err))
+			continue
+		}
+
+		res, err := s.splunkClient.makeRequest(req)
+		if err != nil {
+			s.settings.Logger.Warn("failed to dispatch the warmup search", zap.String("endpoint", ept), zap.Error(err))
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusCreated {
+			s.settings.Logger.Warn("failed to dispatch the warmup search", zap.String("endpoint", ept), zap.Int("status", res.StatusCode))
+		}
+	}
+}
+
+// Reports whether the indexes have to be discovered before scraping, either because they have never been
+// discovered successfully or because the discovery interval has elapsed.
+func (s *splunkScraper) indexDiscoveryDue() bool {
+	if s.conf.IndexDiscoverySearch == "" {
+		return false
+	}
+	if s.discoveredIndexes == nil {
+		return true
+	}
+	return s.conf.IndexDiscoveryInterval > 0 && time.Since(s.discoveredAt) >= s.conf.IndexDiscoveryInterval
+}
+
+// Runs the index discovery search, preferring the search head, and replaces the discovered indexes with
+// its results. The previously discovered indexes are kept when the search fails.
+func (s *splunkScraper) discoverIndexes(ctx context.Context) error {
+	ept := typeIdx
+	for _, t := range []string{typeSh, typeCm} {
+		if s.splunkClient.isConfigured(t) {
+			ept = t
+			break
+		}
+	}
+
+	sr := searchResponse{
+		name:   `IndexDiscovery`,
+		search: url.Values{"search": {s.conf.IndexDiscoverySearch}}.Encode(),
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), ept)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		return err
+	}
+
+	indexes := make(map[string]bool)
+	for _, row := range sr.Results {
+		if index := row["index"]; index != "" {
+			indexes[index] = true
+		}
+	}
+	s.discoveredIndexes = indexes
+	s.discoveredAt = time.Now()
 	return nil
 }
 
+// Fetches the server roles of the endpoints serving APIs only found on instances holding a given role. An
+// endpoint whose roles cannot be fetched is assumed to hold every role, leaving its scrapes to fail instead.
+func (s *splunkScraper) fetchServerRoles(ctx context.Context) {
+	for ept, role := range endpointRoles {
+		if !s.splunkClient.isConfigured(ept) {
+			continue
+		}
+
+		var si ServerInfo
+		res, err := s.serverInfo(ctx, ept)
+		if err == nil {
+			err = json.Unmarshal(res.body, &si)
+		}
+		if err != nil || len(si.Entries) == 0 {
+			s.settings.Logger.Warn("failed to fetch the server roles of the endpoint", zap.String("endpoint_type", ept), zap.Error(err))
+			continue
+		}
+
+		s.serverRoles[ept] = si.Entries[0].Content.ServerRoles
+		if !s.hasRole(ept, role) {
+			s.settings.Logger.Warn("the endpoint does not hold the role its metrics require, skipping them",
+				zap.String("endpoint_type", ept), zap.String("role", role), zap.Strings("server_roles", s.serverRoles[ept]))
+		}
+	}
+}
+
+// Reports whether the instance behind the endpoint holds the role, which it is assumed to when its roles
+// are unknown.
+func (s *splunkScraper) hasRole(ept string, role string) bool {
+	roles, ok := s.serverRoles[ept]
+	if !ok {
+		return true
+	}
+	for _, r := range roles {
+		// cluster managers report the role under its newer name since Splunk 9.0
+		if r == role || (role == roleClusterManager && r == "cluster_manager") {
+			return true
+		}
+	}
+	return false
+}
+
+// Wraps a scrape of APIs only served by instances holding the role the endpoint type is expected to, such
+// as the cluster master APIs, skipping it when the instance turned out not to hold the role.
+func requireRole(ept string, fn scrapeFunc) scrapeFunc {
+	return func(s *splunkScraper, ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+		if !s.hasRole(ept, endpointRoles[ept]) {
+			return
+		}
+		fn(s, ctx, now, errs)
+	}
+}
+
+// Reports whether the search head is the captain of its search head cluster, by comparing its server name
+// with the label of the captain.
+func (s *splunkScraper) isCaptain(ctx context.Context) (bool, error) {
+	var si ServerInfo
+	res, err := s.serverInfo(ctx, typeSh)
+	if err != nil {
+		return false, err
+	}
+	if err = json.Unmarshal(res.body, &si); err != nil {
+		return false, err
+	}
+
+	var st SHClusterStatus
+	if err = s.getJSON(context.WithValue(ctx, endpointType("type"), typeSh), apiDict[`SplunkSHClusterStatus`], &st); err != nil {
+		return false, err
+	}
+
+	if len(si.Entries) == 0 || len(st.Entries) == 0 {
+		return false, nil
+	}
+	return si.Entries[0].Content.ServerName == st.Entries[0].Content.Captain.Label, nil
+}
+
+// Fetches the given API endpoint and unmarshals its JSON response into v.
+func (s *splunkScraper) getJSON(ctx context.Context, ept string, v any) error {
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // The big one: Describes how all scraping tasks should be performed. Part of the scraper interface
 func (s *splunkScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	return s.runScrapes(ctx, s.scrapes(), s.sampleOut())
+}
+
+// Scrapes the metrics of a single named group out of band, so that they can be inspected while
+// troubleshooting without waiting for the next collection interval or lowering it
+func (s *splunkScraper) scrapeGroup(ctx context.Context, group string) (pmetric.Metrics, error) {
+	var scrapes []groupedScrape
+	for _, gs := range s.scrapes() {
+		if gs.group == group {
+			scrapes = append(scrapes, gs)
+		}
+	}
+	if len(scrapes) == 0 {
+		return pmetric.NewMetrics(), fmt.Errorf("%w: %q", errUnknownScrapeGroup, group)
+	}
+	return s.runScrapes(ctx, scrapes, nil)
+}
+
+// Draws the metrics with a sampling probability which are left out of this collection interval
+func (s *splunkScraper) sampleOut() map[string]bool {
+	// drawn in a fixed order so that a seeded sampler always leaves out the same metrics
+	names := make([]string, 0, len(s.conf.Sampling))
+	for name := range s.conf.Sampling {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out map[string]bool
+	for _, name := range names {
+		if s.sampler.Float64() < s.conf.Sampling[name] {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]bool)
+		}
+		out[name] = true
+	}
+	return out
+}
+
+// The scrapes run on every collection interval, in order, each tagged with the group of metrics it records
+func (s *splunkScraper) scrapes() []groupedScrape {
+	return []groupedScrape{
+		{"license", (*splunkScraper).scrapeLicenseUsageByIndex},
+		{"scheduler", (*splunkScraper).scrapeAvgExecLatencyByHost},
+		{"scheduler", (*splunkScraper).scrapeSchedulerCompletionRatioByHost},
+		{"scheduler", (*splunkScraper).scrapeSchedulerSkippedCountByHost},
+		{"indexer", (*splunkScraper).scrapeIndexerAvgRate},
+		{"scheduler", (*splunkScraper).scrapeSchedulerRunTimeByHost},
+		{"indexer", (*splunkScraper).scrapeIndexerRawWriteSecondsByHost},
+		{"indexer", (*splunkScraper).scrapeIndexerCPUSecondsByHost},
+		{"indexer", (*splunkScraper).scrapeIndexEventsPerSecond},
+		{"indexer", (*splunkScraper).scrapeAvgIopsByHost},
+		{"indexer", requireRole(typeIdx, (*splunkScraper).scrapeIndexThroughput)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesTotalSize)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesEventCount)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesBucketCount)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesRawSize)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesBucketEventCount)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesBucketHotWarmCount)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesSizeByState)},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexesDaysUntilFull)},
+		{"lookups", (*splunkScraper).scrapeLookupCount},
+		{"lookups", (*splunkScraper).scrapeLookupSize},
+		{"queues", (*splunkScraper).scrapeIntrospectionQueues},
+		{"queues", (*splunkScraper).scrapeIntrospectionQueuesBytes},
+		{"queues", (*splunkScraper).scrapeIndexerPipelineQueues},
+		{"cluster", (*splunkScraper).scrapeBucketsSearchableStatus},
+		{"indexes", (*splunkScraper).scrapeIndexesBucketCountAdHoc},
+		{"indexes", (*splunkScraper).scrapeIndexBucketActivity},
+		{"indexer", (*splunkScraper).scrapeIngestionErrors},
+		{"indexes", (*splunkScraper).scrapeIndexBucketSizes},
+		{"scheduler", (*splunkScraper).scrapeSchedulerDelegatedCount},
+		{"cluster", requireRole(typeCm, (*splunkScraper).scrapeClusterStatus)},
+		{"cluster", requireRole(typeCm, (*splunkScraper).scrapeClusterFixups)},
+		{"datamodels", (*splunkScraper).scrapeDatamodelBuilds},
+		{"indexes", (*splunkScraper).scrapeIndexSearchableTest},
+		{"dmc", (*splunkScraper).scrapeDMCHealth},
+		{"cluster", (*splunkScraper).scrapeBundlePushSize},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexBucketUtilization)},
+		{"scheduler", (*splunkScraper).scrapeSchedulerContinuedCount},
+		{"cluster", requireRole(typeCm, (*splunkScraper).scrapeClusterGeneration)},
+		{"kvstore", (*splunkScraper).scrapeKVStoreStatus},
+		{"kvstore", (*splunkScraper).scrapeKVStoreCollections},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeSilentIndexes)},
+		{"indexes", (*splunkScraper).scrapeACSIndexes},
+		{"queues", (*splunkScraper).scrapeQueueThroughput},
+		{"indexer", (*splunkScraper).scrapeIndexerThrottledSeconds},
+		{"queues", (*splunkScraper).scrapeBlockedQueuesHealth},
+		{"indexes", requireRole(typeIdx, (*splunkScraper).scrapeIndexConfig)},
+		{"alerts", (*splunkScraper).scrapeRealtimeAlerts},
+		{"alerts", (*splunkScraper).scrapeFiredAlerts},
+		{"indexer", (*splunkScraper).scrapeIndexerSearchesServed},
+		{"indexes", (*splunkScraper).scrapeIndexFrozenArchiveFailures},
+		{"server", (*splunkScraper).scrapeServerUptime},
+		{"indexer", (*splunkScraper).scrapeIndexerEventsDroppedNoIndex},
+		{"cluster", requireRole(typeCm, (*splunkScraper).scrapeClusterBucketsReplicating)},
+		{"cluster", (*splunkScraper).scrapeIndexBucketsQuarantined},
+		{"indexer", (*splunkScraper).scrapeIngestionTruncations},
+		{"cluster", requireRole(typeCm, (*splunkScraper).scrapeClusterIndexRFMetAtIngest)},
+		{"kvstore", (*splunkScraper).scrapeKVStoreLookups},
+		{"scheduler", (*splunkScraper).scrapeLongRunningSearches},
+		{"indexer", (*splunkScraper).scrapeSourcetypeEvents},
+		{"cluster", (*splunkScraper).scrapeClusterPeerChurn},
+		{"cluster", (*splunkScraper).scrapeClusterBucketSyncLag},
+		{"scheduler", (*splunkScraper).scrapeConcurrentSearches},
+		{"scheduler", (*splunkScraper).scrapeDispatchArtifactsPendingDeletion},
+	}
+}
+
+func (s *splunkScraper) runScrapes(ctx context.Context, scrapes []groupedScrape, sampledOut map[string]bool) (pmetric.Metrics, error) {
+	// the metrics builder and the state kept between scrapes are shared by the interval and on-demand scrapes
+	s.scrapeMu.Lock()
+	defer s.scrapeMu.Unlock()
+
+	// the metrics sampled out are disabled for the duration of the scrape, so that the scrape functions skip
+	// the searches only they need
+	if len(sampledOut) > 0 {
+		conf := *s.conf
+		if err := disableMetrics(&conf.MetricsBuilderConfig.Metrics, sampledOut); err != nil {
+			return pmetric.NewMetrics(), err
+		}
+		defer func(conf *Config) { s.conf = conf }(s.conf)
+		s.conf = &conf
+	}
+
+	started := time.Now()
 	errs := &scrapererror.ScrapeErrors{}
-	now := pcommon.NewTimestampFromTime(time.Now())
-
-	s.scrapeLicenseUsageByIndex(ctx, now, errs)
-	s.scrapeAvgExecLatencyByHost(ctx, now, errs)
-	s.scrapeSchedulerCompletionRatioByHost(ctx, now, errs)
-	s.scrapeIndexerAvgRate(ctx, now, errs)
-	s.scrapeSchedulerRunTimeByHost(ctx, now, errs)
-	s.scrapeIndexerRawWriteSecondsByHost(ctx, now, errs)
-	s.scrapeIndexerCPUSecondsByHost(ctx, now, errs)
-	s.scrapeAvgIopsByHost(ctx, now, errs)
-	s.scrapeIndexThroughput(ctx, now, errs)
-	s.scrapeIndexesTotalSize(ctx, now, errs)
-	s.scrapeIndexesEventCount(ctx, now, errs)
-	s.scrapeIndexesBucketCount(ctx, now, errs)
-	s.scrapeIndexesRawSize(ctx, now, errs)
-	s.scrapeIndexesBucketEventCount(ctx, now, errs)
-	s.scrapeIndexesBucketHotWarmCount(ctx, now, errs)
-	s.scrapeIntrospectionQueues(ctx, now, errs)
-	s.scrapeIntrospectionQueuesBytes(ctx, now, errs)
-	s.scrapeIndexerPipelineQueues(ctx, now, errs)
-	s.scrapeBucketsSearchableStatus(ctx, now, errs)
-	s.scrapeIndexesBucketCountAdHoc(ctx, now, errs)
-	return s.mb.Emit(), errs.Combine()
+	clear(s.serverInfos)
+	s.indexesExtendedRes = &indexesExtendedResponse{}
+	now := pcommon.NewTimestampFromTime(s.scrapeTime(ctx))
+
+	s.splunkClient.resetRetryBudget()
+
+	if s.indexDiscoveryDue() {
+		if err := s.discoverIndexes(ctx); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	s.skipClusterScope = false
+	if s.conf.CaptainOnly && s.splunkClient.isConfigured(typeSh) {
+		captain, err := s.isCaptain(ctx)
+		if err != nil {
+			errs.Add(err)
+		}
+		// metrics of the whole cluster are skipped when it is unknown whether this member reports them
+		s.skipClusterScope = !captain
+	}
+
+	var md pmetric.Metrics
+	if s.conf.MaxConcurrentScrapes > 1 {
+		md = s.runConcurrently(ctx, now, started, scrapes, errs)
+	} else {
+		start := time.Now()
+		for i, gs := range scrapes {
+			// stop launching scrapes once the scrape as a whole has run for too long, reporting what was gathered
+			if s.scrapeDurationExceeded(start, len(scrapes)-i, len(scrapes), errs) {
+				break
+			}
+			gs.scrape(s, ctx, now, errs)
+		}
+		s.recordReceiverMetrics(now, started, errs)
+		md = s.mb.Emit()
+	}
+
+	// scrape functions which ran for other metrics may still have recorded those sampled out
+	if len(sampledOut) > 0 {
+		keepMetrics(md, func(name string) bool { return !sampledOut[name] })
+	}
+	if len(s.conf.AttributeNormalizations) > 0 {
+		normalizeAttributes(md, s.conf.AttributeNormalizations)
+	}
+	if s.discoveredIndexes != nil {
+		filterIndexes(md, s.discoveredIndexes)
+	}
+	if !s.conf.IncludeInternalIndexes {
+		dropInternalIndexes(md)
+	}
+	if len(s.conf.AttributeFilters) > 0 {
+		filterAttributes(md, s.conf.AttributeFilters)
+	}
+	if s.conf.DeduplicateDataPoints != "" {
+		deduplicateDataPoints(md, s.conf.DeduplicateDataPoints)
+	}
+	if len(s.conf.UnitOverrides) > 0 {
+		for _, name := range overrideUnits(md, s.conf.UnitOverrides) {
+			if !s.unconvertedUnits[name] {
+				s.unconvertedUnits[name] = true
+				s.settings.Logger.Warn("the unit of the metric cannot be converted to its unit override, emitting it in its default unit",
+					zap.String("metric", name), zap.String("unit_override", s.conf.UnitOverrides[name]))
+			}
+		}
+	}
+	if s.conf.ResourcePerHost {
+		md = groupByHost(md)
+	}
+
+	err := errs.Combine()
+	if err != nil && !scrapererror.IsPartialScrapeError(err) {
+		// the collector drops every metric of a failed scrape, so only the metrics describing the scrape and the
+		// receiver itself are kept, the error made partial for them to be delivered
+		if dropped := keepMetrics(md, isReceiverMetric); md.DataPointCount() > 0 {
+			err = failedScrapeError{PartialScrapeError: scrapererror.NewPartialScrapeError(err, dropped), err: err}
+		}
+	}
+	if s.diagnostics != nil {
+		if werr := s.writeDiagnostics(started, err); werr != nil {
+			s.settings.Logger.Warn("failed to write the diagnostics of the scrape", zap.Error(werr))
+		}
+	}
+	return md, err
 }
 
-// Each metric has its own scrape function associated with it
-func (s *splunkScraper) scrapeLicenseUsageByIndex(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	// Because we have to utilize network resources for each KPI we should check that each metrics
-	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled || !s.splunkClient.isConfigured(typeCm) {
+// The number of scrapes whose diagnostics are kept in the diagnostics directory
+const diagnosticsRetained = 10
+
+// The diagnostics of a scrape, as written to the diagnostics directory
+type scrapeDiagnostics struct {
+	Started  time.Time            `json:"started"`
+	Duration string               `json:"duration"`
+	Error    string               `json:"error,omitempty"`
+	Requests []diagnosticExchange `json:"requests"`
+}
+
+// Writes the requests recorded since the previous scrape, which the first scrape shares with the receiver's
+// start, to a file of their own in the diagnostics directory, removing the files of older scrapes
+func (s *splunkScraper) writeDiagnostics(started time.Time, scrapeErr error) error {
+	d := scrapeDiagnostics{
+		Started:  started.UTC(),
+		Duration: time.Since(started).String(),
+		Requests: s.diagnostics.take(),
+	}
+	if scrapeErr != nil {
+		d.Error = redactCredentials(scrapeErr.Error())
+	}
+
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	// named so that the files sort in the order the scrapes happened
+	name := filepath.Join(s.conf.DiagnosticsDir, fmt.Sprintf("scrape-%s.json", started.UTC().Format("20060102T150405.000000000Z")))
+	if err = os.WriteFile(name, b, 0o600); err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(s.conf.DiagnosticsDir, "scrape-*.json"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	for len(files) > diagnosticsRetained {
+		if err = os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Records the metrics describing the receiver itself rather than Splunk, once every scrape has completed
+func (s *splunkScraper) recordReceiverMetrics(now pcommon.Timestamp, started time.Time, errs *scrapererror.ScrapeErrors) {
+	s.mb.RecordSplunkScrapeDurationSecondsDataPoint(now, time.Since(started).Seconds())
+	err := errs.Combine()
+	s.mb.RecordSplunkScrapeSuccessDataPoint(now, boolToInt(err == nil || scrapererror.IsPartialScrapeError(err)))
+
+	for name, timeouts := range s.searchTimeouts {
+		s.mb.RecordSplunkReceiverSearchTimeoutDataPoint(now, timeouts, name)
+	}
+	if s.connections == nil {
+		return
+	}
+	for ept, t := range s.connections.take() {
+		if t.dns > 0 {
+			s.mb.RecordSplunkReceiverRequestDNSTimeDataPoint(now, t.dns.Seconds(), endpointNames[ept])
+		}
+		if t.tlsHandshake > 0 {
+			s.mb.RecordSplunkReceiverRequestTLSHandshakeTimeDataPoint(now, t.tlsHandshake.Seconds(), endpointNames[ept])
+		}
+		if t.firstByte > 0 {
+			s.mb.RecordSplunkReceiverRequestFirstByteTimeDataPoint(now, t.firstByte.Seconds(), endpointNames[ept])
+		}
+	}
+}
+
+// Reports whether the scrape as a whole has run for longer than the maximum scrape duration, adding the
+// partial scrape error for the scrapes left to run when it has
+func (s *splunkScraper) scrapeDurationExceeded(start time.Time, skipped int, total int, errs *scrapererror.ScrapeErrors) bool {
+	if s.conf.MaxScrapeDuration <= 0 || time.Since(start) <= s.conf.MaxScrapeDuration {
+		return false
+	}
+	errs.AddPartial(skipped, fmt.Errorf("%w after %s, skipped %d of %d scrapes", errMaxScrapeDurationExceeded, s.conf.MaxScrapeDuration, skipped, total))
+	return true
+}
+
+// Runs up to MaxConcurrentScrapes of the scrapes at once. The metrics builder and the scrape errors are not
+// safe for concurrent use, so each scrape records into a builder and errors of its own, merged in the order
+// of the scrapes once they have all completed. The rest of the scraper state is shared, its caches
+// guarded by stateMu.
+func (s *splunkScraper) runConcurrently(ctx context.Context, now pcommon.Timestamp, started time.Time, scrapes []groupedScrape, errs *scrapererror.ScrapeErrors) pmetric.Metrics {
+	type result struct {
+		md   pmetric.Metrics
+		errs scrapererror.ScrapeErrors
+		ran  bool
+	}
+	results := make([]result, len(scrapes))
+
+	sem := make(chan struct{}, s.conf.MaxConcurrentScrapes)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i, gs := range scrapes {
+		sem <- struct{}{}
+		// stop launching scrapes once the scrape as a whole has run for too long, reporting what was gathered
+		if s.scrapeDurationExceeded(start, len(scrapes)-i, len(scrapes), errs) {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(r *result, scrape scrapeFunc) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			w := *s
+			w.mb = metadata.NewMetricsBuilder(s.conf.MetricsBuilderConfig, s.params, metadata.WithStartTime(s.startTime))
+			scrape(&w, ctx, now, &r.errs)
+			r.md = w.mb.Emit()
+			r.ran = true
+		}(&results[i], gs.scrape)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		if err := r.errs.Combine(); err != nil {
+			errs.Add(err)
+		}
+	}
+	s.recordReceiverMetrics(now, started, errs)
+	md := s.mb.Emit()
+	for _, r := range results {
+		if r.ran {
+			mergeMetrics(md, r.md)
+		}
+	}
+	return md
+}
+
+// Moves the metrics of src into dst, appending the data points of the metrics both have to those of dst
+func mergeMetrics(dst pmetric.Metrics, src pmetric.Metrics) {
+	if dst.ResourceMetrics().Len() == 0 {
+		src.ResourceMetrics().MoveAndAppendTo(dst.ResourceMetrics())
+		return
+	}
+	into := dst.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	rms := src.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				existing, ok := findMetric(into, m.Name())
+				if !ok {
+					m.MoveTo(into.AppendEmpty())
+					continue
+				}
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					m.Gauge().DataPoints().MoveAndAppendTo(existing.Gauge().DataPoints())
+				case pmetric.MetricTypeSum:
+					m.Sum().DataPoints().MoveAndAppendTo(existing.Sum().DataPoints())
+				}
+			}
+		}
+	}
+}
+
+// The error of a scrape which failed as a whole, made partial for the collector to deliver the metrics
+// describing the failure while still matching the errors the scrape failed with
+type failedScrapeError struct {
+	scrapererror.PartialScrapeError
+	err error
+}
+
+func (e failedScrapeError) Unwrap() []error {
+	return []error{e.PartialScrapeError, e.err}
+}
+
+// Reports whether a metric describes the scrape or the receiver itself rather than Splunk
+func isReceiverMetric(name string) bool {
+	return strings.HasPrefix(name, "splunk.scrape.") || strings.HasPrefix(name, "splunk.receiver.")
+}
+
+// Disables the named metrics in mc, failing on names which are not metrics of the receiver
+func disableMetrics(mc *metadata.MetricsConfig, names map[string]bool) error {
+	disabled := make(map[string]any, len(names))
+	for name := range names {
+		disabled[name] = map[string]any{"enabled": false}
+	}
+	return confmap.NewFromStringMap(disabled).Unmarshal(mc)
+}
+
+// Removes the metrics whose name is not kept from md, returning the number of data points removed
+func keepMetrics(md pmetric.Metrics, keep func(name string) bool) int {
+	removed := md.DataPointCount()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sms.At(j).Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				return !keep(m.Name())
+			})
+		}
+	}
+	return removed - md.DataPointCount()
+}
+
+func findMetric(ms pmetric.MetricSlice, name string) (pmetric.Metric, bool) {
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i), true
+		}
+	}
+	return pmetric.Metric{}, false
+}
+
+// Moves the data points carrying a splunk.host attribute to a resource per host, identified by the host.name
+// resource attribute. Data points without a host stay on a copy of the resource they were emitted on.
+func groupByHost(md pmetric.Metrics) pmetric.Metrics {
+	out := pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		// the resource, scope and metric each host's data points are moved to
+		resources := make(map[string]pmetric.ResourceMetrics)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			scopes := make(map[string]pmetric.ScopeMetrics)
+			ms := sm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				dps, ok := numberDataPoints(m)
+				if !ok {
+					continue
+				}
+				metrics := make(map[string]pmetric.Metric)
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					var host string
+					if v, ok := dp.Attributes().Get("splunk.host"); ok {
+						host = v.AsString()
+					}
+
+					gm, ok := metrics[host]
+					if !ok {
+						gs, ok := scopes[host]
+						if !ok {
+							gr, ok := resources[host]
+							if !ok {
+								gr = out.ResourceMetrics().AppendEmpty()
+								gr.SetSchemaUrl(rm.SchemaUrl())
+								rm.Resource().CopyTo(gr.Resource())
+								if host != "" {
+									gr.Resource().Attributes().PutStr("host.name", host)
+								}
+								resources[host] = gr
+							}
+							gs = gr.ScopeMetrics().AppendEmpty()
+							gs.SetSchemaUrl(sm.SchemaUrl())
+							sm.Scope().CopyTo(gs.Scope())
+							scopes[host] = gs
+						}
+						gm = gs.Metrics().AppendEmpty()
+						copyMetricDescriptor(m, gm)
+						metrics[host] = gm
+					}
+
+					moved, _ := numberDataPoints(gm)
+					dp.CopyTo(moved.AppendEmpty())
+					moved.At(moved.Len() - 1).Attributes().Remove("splunk.host")
+				}
+			}
+		}
+	}
+	return out
+}
+
+// Returns the data points of a gauge or a sum.
+func numberDataPoints(m pmetric.Metric) (pmetric.NumberDataPointSlice, bool) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return m.Gauge().DataPoints(), true
+	case pmetric.MetricTypeSum:
+		return m.Sum().DataPoints(), true
+	}
+	return pmetric.NumberDataPointSlice{}, false
+}
+
+// Sets up dst as an empty metric of the same name, unit and type as src.
+func copyMetricDescriptor(src, dst pmetric.Metric) {
+	dst.SetName(src.Name())
+	dst.SetDescription(src.Description())
+	dst.SetUnit(src.Unit())
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dst.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dst.SetEmptySum()
+		sum.SetIsMonotonic(src.Sum().IsMonotonic())
+		sum.SetAggregationTemporality(src.Sum().AggregationTemporality())
+	}
+}
+
+// Merges the data points of each metric that share the same attributes. Searches can return more than one
+// row for the same attribute set, and most backends either reject or double count the resulting points.
+func deduplicateDataPoints(md pmetric.Metrics, mode string) {
+	forEachDataPoints(md, func(_ string, dps pmetric.NumberDataPointSlice) {
+		mergeDuplicates(dps, mode)
+	})
+}
+
+// Removes the attributes filtered out for each metric and sums the data points that end up with the same
+// attributes, so that dropping an attribute aggregates its values rather than producing duplicates.
+func filterAttributes(md pmetric.Metrics, filters map[string]AttributeFilter) {
+	forEachDataPoints(md, func(name string, dps pmetric.NumberDataPointSlice) {
+		f, ok := filters[name]
+		if !ok {
+			return
+		}
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+				return !f.retains(k)
+			})
+		}
+		mergeDuplicates(dps, dedupSum)
+	})
+}
+
+// Rewrites the values of the normalized attributes, summing the data points of a metric which end up with the
+// same attributes
+func normalizeAttributes(md pmetric.Metrics, norms map[string]AttributeNormalization) {
+	forEachDataPoints(md, func(_ string, dps pmetric.NumberDataPointSlice) {
+		changed := false
+		for i := 0; i < dps.Len(); i++ {
+			attrs := dps.At(i).Attributes()
+			for name, n := range norms {
+				v, ok := attrs.Get(name)
+				if !ok || v.Type() != pcommon.ValueTypeStr {
+					continue
+				}
+				if nv := n.apply(v.Str()); nv != v.Str() {
+					v.SetStr(nv)
+					changed = true
+				}
+			}
+		}
+		if changed {
+			mergeDuplicates(dps, dedupSum)
+		}
+	})
+}
+
+// Emits the metrics with a unit override in the overriding unit, scaling their values to match. Values are
+// emitted as doubles once scaled since the conversion may leave a fraction. The names of the metrics whose
+// default unit cannot be converted to their override are returned, their data left as is.
+func overrideUnits(md pmetric.Metrics, overrides map[string]string) []string {
+	var unconverted []string
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				to, ok := overrides[m.Name()]
+				if !ok || to == m.Unit() {
+					continue
+				}
+				from, fok := unitScales[m.Unit()]
+				target := unitScales[to]
+				if !fok || from.dimension != target.dimension {
+					unconverted = append(unconverted, m.Name())
+					continue
+				}
+
+				var dps pmetric.NumberDataPointSlice
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					dps = m.Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = m.Sum().DataPoints()
+				default:
+					continue
+				}
+				factor := from.scale / target.scale
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+						dp.SetDoubleValue(float64(dp.IntValue()) * factor)
+					} else {
+						dp.SetDoubleValue(dp.DoubleValue() * factor)
+					}
+				}
+				m.SetUnit(to)
+			}
+		}
+	}
+	return unconverted
+}
+
+// Metrics reporting on indexes which do not exist, whose data points are never filtered by index discovery
+var missingIndexMetrics = map[string]bool{
+	"splunk.indexer.events_dropped_no_index": true,
+}
+
+// Removes the data points of indexes which were not discovered. Data points which are not about an index
+// are left untouched.
+func filterIndexes(md pmetric.Metrics, indexes map[string]bool) {
+	forEachDataPoints(md, func(name string, dps pmetric.NumberDataPointSlice) {
+		if missingIndexMetrics[name] {
+			return
+		}
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			index, ok := dp.Attributes().Get("splunk.index.name")
+			return ok && !indexes[index.Str()]
+		})
+	})
+}
+
+// Removes the data points of internal indexes, whose names start with an underscore.
+func dropInternalIndexes(md pmetric.Metrics) {
+	forEachDataPoints(md, func(_ string, dps pmetric.NumberDataPointSlice) {
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			index, ok := dp.Attributes().Get("splunk.index.name")
+			return ok && strings.HasPrefix(index.Str(), "_")
+		})
+	})
+}
+
+// Calls fn with the data points of every gauge and sum in md.
+func forEachDataPoints(md pmetric.Metrics, fn func(name string, dps pmetric.NumberDataPointSlice)) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				switch m := ms.At(k); m.Type() {
+				case pmetric.MetricTypeGauge:
+					fn(m.Name(), m.Gauge().DataPoints())
+				case pmetric.MetricTypeSum:
+					fn(m.Name(), m.Sum().DataPoints())
+				}
+			}
+		}
+	}
+}
+
+// Folds the data points sharing the same attributes into the first of them.
+func mergeDuplicates(dps pmetric.NumberDataPointSlice, mode string) {
+	seen := make(map[string]pmetric.NumberDataPoint, dps.Len())
+	dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		key := attributesKey(dp.Attributes())
+		kept, ok := seen[key]
+		if !ok {
+			seen[key] = dp
+			return false
+		}
+		mergeDataPoint(kept, dp, mode)
+		return true
+	})
+}
+
+// Folds dup into kept, either replacing the value of kept or adding to it.
+func mergeDataPoint(kept, dup pmetric.NumberDataPoint, mode string) {
+	if mode != dedupSum {
+		dup.CopyTo(kept)
+		return
+	}
+
+	switch kept.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		kept.SetIntValue(kept.IntValue() + dup.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		kept.SetDoubleValue(kept.DoubleValue() + dup.DoubleValue())
+	}
+}
+
+// Builds a key identifying an attribute set regardless of the order the attributes were recorded in.
+func attributesKey(attrs pcommon.Map) string {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(v.AsString())
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// Returns the time used to timestamp the data points of a scrape. This is the collector's clock unless the
+// receiver is configured to use the clock of the Splunk server.
+func (s *splunkScraper) scrapeTime(ctx context.Context) time.Time {
+	local := time.Now()
+	if !s.conf.UseServerTime {
+		return local
+	}
+
+	server, err := s.serverTime(ctx)
+	if err != nil {
+		s.settings.Logger.Warn("failed to read the Splunk server's time, falling back to local time", zap.Error(err))
+		return local
+	}
+
+	skew := server.Sub(local)
+	if skew.Abs() > s.conf.ClockSkewTolerance {
+		s.settings.Logger.Warn("clock skew between the collector and the Splunk server exceeds tolerance",
+			zap.Duration("skew", skew),
+			zap.Duration("tolerance", s.conf.ClockSkewTolerance))
+	}
+
+	// the Date header only has second precision so keep the precision of the local clock
+	return local.Add(skew.Round(time.Second))
+}
+
+// Reads the current time of the first configured Splunk server from the Date header of a server info request
+func (s *splunkScraper) serverTime(ctx context.Context) (time.Time, error) {
+	for _, t := range []string{typeCm, typeSh, typeIdx} {
+		if !s.splunkClient.isConfigured(t) {
+			continue
+		}
+
+		si, err := s.serverInfo(ctx, t)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		// failed requests still carry a Date header, but only successful responses are kept
+		return http.ParseTime(si.date)
+	}
+	return time.Time{}, errNoClientFound
+}
+
+// Fetches the server/info of the given endpoint type, reusing the response already fetched during the scrape
+func (s *splunkScraper) serverInfo(ctx context.Context, t string) (*serverInfoResponse, error) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if si, ok := s.serverInfos[t]; ok {
+		return si, nil
+	}
+
+	req, err := s.splunkClient.createAPIRequest(context.WithValue(ctx, endpointType("type"), t), apiDict[`SplunkServerInfo`])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	si := &serverInfoResponse{date: res.Header.Get("Date"), body: body}
+	s.serverInfos[t] = si
+	return si, nil
+}
+
+// Returns the indexes-extended listing of the indexer tier, fetched at most once per scrape and shared by
+// every metric read from it. A failure to fetch it is returned to each of them without fetching it again.
+func (s *splunkScraper) indexesExtended(ctx context.Context) (*IndexesExtended, error) {
+	s.indexesExtendedRes.once.Do(func() {
+		// each indexer holds its own share of the buckets of an index, so the listing of the tier is
+		// incomplete without any one of them
+		var listings []*IndexesExtended
+		for _, ctx := range s.splunkClient.indexerContexts(ctx) {
+			var it IndexesExtended
+			if err := s.getJSON(ctx, apiDict[`SplunkDataIndexesExtended`], &it); err != nil {
+				s.indexesExtendedRes.err = err
+				return
+			}
+			listings = append(listings, &it)
+		}
+		s.indexesExtendedRes.indexes = mergeIndexesExtended(listings)
+	})
+	if s.indexesExtendedRes.err != nil {
+		return nil, s.indexesExtendedRes.err
+	}
+	return s.indexesExtendedRes.indexes, nil
+}
+
+// Merges the indexes-extended listings of several indexers into one holding a single entry per index. The
+// counts, sizes and size limits of an index are summed across the indexers holding it, and its time bounds
+// widened to cover all of them. Entries without a name are kept as they are.
+func mergeIndexesExtended(listings []*IndexesExtended) *IndexesExtended {
+	if len(listings) == 1 {
+		return listings[0]
+	}
+
+	merged := &IndexesExtended{}
+	pos := make(map[string]int)
+	for _, it := range listings {
+		for _, e := range it.Entries {
+			if i, ok := pos[e.Name]; ok {
+				merged.Entries[i].Content.merge(e.Content)
+				continue
+			}
+			if e.Name != "" {
+				pos[e.Name] = len(merged.Entries)
+			}
+			merged.Entries = append(merged.Entries, e)
+		}
+	}
+	return merged
+}
+
+func (c *IdxEContent) merge(o IdxEContent) {
+	c.TotalBucketCount = sumNumbers(c.TotalBucketCount, o.TotalBucketCount)
+	c.TotalEventCount += o.TotalEventCount
+	c.TotalSize = sumNumbers(c.TotalSize, o.TotalSize)
+	c.TotalRawSize = sumNumbers(c.TotalRawSize, o.TotalRawSize)
+	// the limits apply to each indexer, so those of the tier are their sum
+	c.MaxTotalDataSize += o.MaxTotalDataSize
+	c.MaxWarmDBCount += o.MaxWarmDBCount
+	if later, err := time.Parse(time.RFC3339, o.MaxTime); err == nil {
+		if latest, err := time.Parse(time.RFC3339, c.MaxTime); err != nil || later.After(latest) {
+			c.MaxTime = o.MaxTime
+		}
+	}
+	c.BucketDirs.Cold.merge(o.BucketDirs.Cold)
+	c.BucketDirs.Home.merge(o.BucketDirs.Home)
+	c.BucketDirs.Thawed.merge(o.BucketDirs.Thawed)
+}
+
+func (d *IdxEBucketDirsDetails) merge(o IdxEBucketDirsDetails) {
+	d.Capacity = sumNumbers(d.Capacity, o.Capacity)
+	d.EventCount = sumNumbers(d.EventCount, o.EventCount)
+	d.EventMaxTime = boundNumbers(d.EventMaxTime, o.EventMaxTime, false)
+	d.EventMinTime = boundNumbers(d.EventMinTime, o.EventMinTime, true)
+	d.HotBucketCount = sumNumbers(d.HotBucketCount, o.HotBucketCount)
+	d.HotBucketSize = sumNumbers(d.HotBucketSize, o.HotBucketSize)
+	d.WarmBucketCount = sumNumbers(d.WarmBucketCount, o.WarmBucketCount)
+	d.WarmBucketSize = sumNumbers(d.WarmBucketSize, o.WarmBucketSize)
+	d.BucketSize = sumNumbers(d.BucketSize, o.BucketSize)
+}
+
+// Sums two numbers reported as strings, either of which may be missing. A value which is not a number is
+// kept in place of the sum, for the error to be reported when the merged entry is read.
+func sumNumbers(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return a
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return b
+	}
+	return strconv.FormatFloat(x+y, 'f', -1, 64)
+}
+
+// Returns the larger of two numbers reported as strings, or the smaller when smallest is set. Either may be
+// missing, and a value which is not a number is kept in place of the other.
+func boundNumbers(a, b string, smallest bool) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return a
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return b
+	}
+	if (y < x) == smallest {
+		return b
+	}
+	return a
+}
+
+// Each metric has its own scrape function associated with it
+func (s *splunkScraper) scrapeLicenseUsageByIndex(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkLicenseIndexUsage.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	sr := searchResponse{
+		name:   `SplunkLicenseIndexUsageSearch`,
+		search: searchDict[`SplunkLicenseIndexUsageSearch`],
+	}
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkLicenseIndexUsageSearch", &sr, errs)
+	s.mapSearchFields("SplunkLicenseIndexUsageSearch", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		indexName := row["indexname"]
+		if v, ok := row.parseFloat("By", errs); ok {
+			s.mb.RecordSplunkLicenseIndexUsageDataPoint(ts, int64(v), indexName)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeAvgExecLatencyByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerAvgExecutionLatency.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkSchedulerAvgExecLatencySearch`,
+		search: searchDict[`SplunkSchedulerAvgExecLatencySearch`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerAvgExecLatencySearch", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerAvgExecLatencySearch", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("latency_avg_exec", errs); ok {
+			s.mb.RecordSplunkSchedulerAvgExecutionLatencyDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeIndexerAvgRate(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerAvgRate.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexerAvgRate`,
+		search: searchDict[`SplunkIndexerAvgRate`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerAvgRate", &sr, errs)
+	s.mapSearchFields("SplunkIndexerAvgRate", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("indexer_avg_kbps", errs); ok {
+			s.mb.RecordSplunkIndexerAvgRateDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeIndexerPipelineQueues(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkAggregationQueueRatio.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkPipelineQueues`,
+		search: searchDict[`SplunkPipelineQueues`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkPipelineQueues", &sr, errs)
+	s.mapSearchFields("SplunkPipelineQueues", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("agg_queue_ratio", errs); ok {
+			s.mb.RecordSplunkAggregationQueueRatioDataPoint(ts, v, host)
+		}
+		if v, ok := row.parseFloat("index_queue_ratio", errs); ok {
+			s.mb.RecordSplunkIndexerQueueRatioDataPoint(ts, v, host)
+		}
+		if v, ok := row.parseFloat("parse_queue_ratio", errs); ok {
+			s.mb.RecordSplunkParseQueueRatioDataPoint(ts, v, host)
+		}
+		if v, ok := row.parseInt("pipeline_sets", errs); ok {
+			s.mb.RecordSplunkPipelineSetCountDataPoint(ts, v, host)
+		}
+		if v, ok := row.parseFloat("typing_queue_ratio", errs); ok {
+			s.mb.RecordSplunkTypingQueueRatioDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeBucketsSearchableStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkBucketsSearchableStatus.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkBucketsSearchableStatus`,
+		search: searchDict[`SplunkBucketsSearchableStatus`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkBucketsSearchableStatus", &sr, errs)
+	s.mapSearchFields("SplunkBucketsSearchableStatus", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		searchable := row["is_searchable"]
+		if v, ok := row.parseInt("bucket_count", errs); ok {
+			s.mb.RecordSplunkBucketsSearchableStatusDataPoint(ts, v, host, searchable)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeIndexesBucketCountAdHoc(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkIndexesSize.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkIndexRetentionUtilizationRatio.Enabled) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexesData`,
+		search: searchDict[`SplunkIndexesData`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexesData", &sr, errs)
+	s.mapSearchFields("SplunkIndexesData", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		indexer := row["title"]
+		if v, ok := row.parseFloat("total_size_gb", errs); ok {
+			s.mb.RecordSplunkIndexesSizeDataPoint(ts, v, indexer)
+		}
+		if v, ok := row.parseFloat("average_size_gb", errs); ok {
+			s.mb.RecordSplunkIndexesAvgSizeDataPoint(ts, v, indexer)
+		}
+		if v, ok := row.parseFloat("average_usage_perc", errs); ok {
+			s.mb.RecordSplunkIndexesAvgUsageDataPoint(ts, v, indexer)
+		}
+		var dataAge int64
+		if v, ok := row.parseInt("median_data_age", errs); ok {
+			s.mb.RecordSplunkIndexesMedianDataAgeDataPoint(ts, v, indexer)
+			dataAge = v
+		}
+		if v, ok := row.parseInt("bucket_count", errs); ok {
+			s.mb.RecordSplunkIndexesBucketCountDataPoint(ts, v, indexer)
+		}
+		if v, ok := row.parseFloat("frozen_time_period_secs", errs); ok {
+			// indexes without a retention period never roll data to frozen by age. The median data age
+			// is reported in days
+			if v > 0 {
+				s.mb.RecordSplunkIndexRetentionUtilizationRatioDataPoint(ts, float64(dataAge)*86400/v, indexer)
+			}
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeSchedulerCompletionRatioByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerCompletionRatio.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedTotal.Enabled) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkSchedulerCompletionRatio`,
+		search: searchDict[`SplunkSchedulerCompletionRatio`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerCompletionRatio", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerCompletionRatio", &sr)
+
+	// Record the results
+	var skipped int64
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("completion_ratio", errs); ok {
+			s.mb.RecordSplunkSchedulerCompletionRatioDataPoint(ts, v, host)
+		}
+		if v, ok := row.parseInt("skipped_exec", errs); ok {
+			skipped += v
+		}
+	}
+	// the rollup of the skipped searches of every host
+	s.mb.RecordSplunkSchedulerSkippedTotalDataPoint(now, skipped)
+}
+
+func (s *splunkScraper) scrapeIndexerRawWriteSecondsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerRawWriteTime.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexerRawWriteSeconds`,
+		search: searchDict[`SplunkIndexerRawWriteSeconds`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerRawWriteSeconds", &sr, errs)
+	s.mapSearchFields("SplunkIndexerRawWriteSeconds", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("raw_data_write_seconds", errs); ok {
+			s.mb.RecordSplunkIndexerRawWriteTimeDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeIndexerCPUSecondsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerCPUTime.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexerCpuSeconds`,
+		search: searchDict[`SplunkIndexerCpuSeconds`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerCpuSeconds", &sr, errs)
+	s.mapSearchFields("SplunkIndexerCpuSeconds", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("service_cpu_seconds", errs); ok {
+			s.mb.RecordSplunkIndexerCPUTimeDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeAvgIopsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIoAvgIops.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIoAvgIops`,
+		search: searchDict[`SplunkIoAvgIops`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIoAvgIops", &sr, errs)
+	s.mapSearchFields("SplunkIoAvgIops", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseInt("iops", errs); ok {
+			s.mb.RecordSplunkIoAvgIopsDataPoint(ts, v, host)
+		}
+	}
+}
+
+func (s *splunkScraper) scrapeSchedulerRunTimeByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerAvgRunTime.Enabled {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkSchedulerAvgRunTime`,
+		search: searchDict[`SplunkSchedulerAvgRunTime`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerAvgRunTime", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerAvgRunTime", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseFloat("run_time_avg", errs); ok {
+			s.mb.RecordSplunkSchedulerAvgRunTimeDataPoint(ts, v, host)
+		}
+	}
+}
+
+// Scrape the number of buckets merged and rolled from hot to warm per index
+func (s *splunkScraper) scrapeIndexBucketActivity(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if (!s.conf.MetricsBuilderConfig.Metrics.SplunkIndexBucketMerges.Enabled && !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexBucketRolls.Enabled) || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexBucketActivity`,
+		search: searchDict[`SplunkIndexBucketActivity`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexBucketActivity", &sr, errs)
+	s.mapSearchFields("SplunkIndexBucketActivity", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		indexName := row["indexname"]
+		if v, ok := row.parseInt("bucket_merges", errs); ok {
+			s.mb.RecordSplunkIndexBucketMergesDataPoint(ts, v, indexName)
+		}
+		if v, ok := row.parseInt("bucket_rolls", errs); ok {
+			s.mb.RecordSplunkIndexBucketRollsDataPoint(ts, v, indexName)
+		}
+	}
+}
+
+// Scrape the number of errors logged by the ingestion pipeline processors per host and component
+func (s *splunkScraper) scrapeIngestionErrors(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIngestionErrors.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIngestionErrors`,
+		search: searchDict[`SplunkIngestionErrors`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIngestionErrors", &sr, errs)
+	s.mapSearchFields("SplunkIngestionErrors", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		comp := row["component"]
+		if v, ok := row.parseInt("errors", errs); ok {
+			s.mb.RecordSplunkIngestionErrorsDataPoint(ts, v, host, comp)
+		}
+	}
+}
+
+// The search quota applies to the Splunk role rather than to a single receiver, so the search slots of
+// an endpoint are shared by every receiver that dispatches searches to it.
+var (
+	searchSemsMu sync.Mutex
+	searchSems   = map[string]chan struct{}{}
+)
+
+// Returns the search slots shared by all receivers targeting endpoint. The first receiver to target the
+// endpoint determines how many searches may be outstanding at once.
+func sharedSearchSem(endpoint string, limit int) chan struct{} {
+	searchSemsMu.Lock()
+	defer searchSemsMu.Unlock()
+
+	sem, ok := searchSems[endpoint]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		searchSems[endpoint] = sem
+	}
+	return sem
+}
+
+// Scrape the average and maximum bucket size per index
+func (s *splunkScraper) scrapeIndexBucketSizes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if (!s.conf.MetricsBuilderConfig.Metrics.SplunkIndexBucketAvgSizeBytes.Enabled && !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexBucketMaxSizeBytes.Enabled) || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkIndexBucketSizes`,
+		search: searchDict[`SplunkIndexBucketSizes`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexBucketSizes", &sr, errs)
+	s.mapSearchFields("SplunkIndexBucketSizes", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		indexName := row["indexname"]
+		if v, ok := row.parseInt("avg_size_bytes", errs); ok {
+			s.mb.RecordSplunkIndexBucketAvgSizeBytesDataPoint(ts, v, indexName)
+		}
+		if v, ok := row.parseInt("max_size_bytes", errs); ok {
+			s.mb.RecordSplunkIndexBucketMaxSizeBytesDataPoint(ts, v, indexName)
+		}
+	}
+}
+
+// Scrape the number of scheduled searches delegated to each member of a search head cluster
+func (s *splunkScraper) scrapeSchedulerDelegatedCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerDelegatedCount.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkSchedulerDelegatedCount`,
+		search: searchDict[`SplunkSchedulerDelegatedCount`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerDelegatedCount", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerDelegatedCount", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseInt("delegated", errs); ok {
+			s.mb.RecordSplunkSchedulerDelegatedCountDataPoint(ts, v, host)
+		}
+	}
+}
+
+// Scrape the indexing readiness and maintenance mode flags of the indexer cluster
+func (s *splunkScraper) scrapeClusterStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkClusterIndexingReady.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkClusterMaintenanceMode.Enabled) || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+	var ci ClusterMasterInfo
+
+	ept := apiDict[`SplunkClusterMasterInfo`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &ci)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	for _, e := range ci.Entries {
+		s.mb.RecordSplunkClusterIndexingReadyDataPoint(now, boolToInt(e.Content.IndexingReady))
+		s.mb.RecordSplunkClusterMaintenanceModeDataPoint(now, boolToInt(e.Content.MaintenanceMode))
+	}
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// The fixup levels reported on. Buckets pending at these levels are not fully searchable or replicated.
+var fixupLevels = []string{"search_factor", "replication_factor"}
+
+// Scrape the number of buckets pending fixup and the age of the oldest of them for each fixup level
+func (s *splunkScraper) scrapeClusterFixups(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkClusterFixupPending.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkClusterFixupOldestAgeSeconds.Enabled) || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	for _, level := range fixupLevels {
+		var cf ClusterMasterFixup
+
+		ept := fmt.Sprintf(apiDict[`SplunkClusterMasterFixup`], level)
+
+		req, err := s.splunkClient.createAPIRequest(ctx, ept)
+		if err != nil {
+			errs.Add(err)
+			return
+		}
+
+		res, err := s.splunkClient.makeRequest(req)
+		if err != nil {
+			errs.Add(err)
+			return
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			errs.Add(err)
+			return
+		}
+
+		err = json.Unmarshal(body, &cf)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		// the age of a fixup is measured from when the bucket was first queued for it
+		var oldest int64
+		for _, e := range cf.Entries {
+			if ts := e.Content.Initial.Timestamp; ts > 0 && (oldest == 0 || ts < oldest) {
+				oldest = ts
+			}
+		}
+
+		s.mb.RecordSplunkClusterFixupPendingDataPoint(now, int64(len(cf.Entries)), level)
+		var age int64
+		if oldest > 0 {
+			age = max(0, now.AsTime().Unix()-oldest)
+		}
+		s.mb.RecordSplunkClusterFixupOldestAgeSecondsDataPoint(now, age, level)
+	}
+}
+
+// Scrape the number of data model acceleration summaries being built
+func (s *splunkScraper) scrapeDatamodelBuilds(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDatamodelBuildsRunning.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var sum Summarization
+
+	ept := apiDict[`SplunkSummarization`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &sum)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	var running int64
+	for _, e := range sum.Entries {
+		if e.Content.InProgress {
+			running++
+		}
+	}
+	s.mb.RecordSplunkDatamodelBuildsRunningDataPoint(now, running)
+}
+
+// Run a test search against each of the configured indexes to check that they return events
+func (s *splunkScraper) scrapeIndexSearchableTest(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexSearchableTest.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	for _, index := range s.conf.SearchableTestIndexes {
+		s.testIndexSearchable(ctx, now, index, errs)
+	}
+}
+
+func (s *splunkScraper) testIndexSearchable(ctx context.Context, now pcommon.Timestamp, index string, errs *scrapererror.ScrapeErrors) {
+	sr := searchResponse{
+		name:   `SplunkIndexSearchableTest`,
+		search: fmt.Sprintf(searchDict[`SplunkIndexSearchableTest`], url.QueryEscape(index)),
+	}
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	// an index the search cannot read from returns no events rather than an error
+	var searchable int64
+	for _, row := range sr.Results {
+		events, ok := row["events"]
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseInt(events, 10, 64)
+		if err != nil {
+			errs.Add(err)
+			return
+		}
+		if v > 0 {
+			searchable = 1
+		}
+	}
+	s.mb.RecordSplunkIndexSearchableTestDataPoint(now, searchable, index)
+}
+
+// Scrape the health of the instances of the deployment as seen by the Monitoring Console
+func (s *splunkScraper) scrapeDMCHealth(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkDmcInstancesUnhealthy.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkDmcInstances.Enabled) || !s.splunkClient.isConfigured(typeSh) || s.skipClusterScope {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var dp DistributedPeers
+
+	ept := apiDict[`SplunkDistributedPeers`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &dp)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	var unhealthy int64
+	byRole := map[[2]string]int64{}
+	for _, e := range dp.Entries {
+		status := "healthy"
+		if !strings.EqualFold(e.Content.Status, "Up") || !strings.EqualFold(e.Content.HealthStatus, "Healthy") {
+			status = "unhealthy"
+			unhealthy++
+		}
+		for _, role := range e.Content.ServerRoles {
+			byRole[[2]string{role, status}]++
+		}
+	}
+
+	s.mb.RecordSplunkDmcInstancesUnhealthyDataPoint(now, unhealthy)
+	for k, v := range byRole {
+		s.mb.RecordSplunkDmcInstancesDataPoint(now, v, k[0], k[1])
+	}
+}
+
+// Scrape the size of the knowledge bundle pushed to each search peer
+func (s *splunkScraper) scrapeBundlePushSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkBundlePushSizeBytes.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkBundlePushSize`,
+		search: searchDict[`SplunkBundlePushSize`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkBundlePushSize", &sr, errs)
+	s.mapSearchFields("SplunkBundlePushSize", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		peer := row["peer"]
+		if v, ok := row.parseInt("size_bytes", errs); ok {
+			s.mb.RecordSplunkBundlePushSizeBytesDataPoint(ts, v, peer)
+		}
+	}
+}
+
+// Scrape the number of times each scheduled search was continued
+func (s *splunkScraper) scrapeSchedulerContinuedCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerContinuedCount.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkSchedulerContinuedCount`,
+		search: searchDict[`SplunkSchedulerContinuedCount`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerContinuedCount", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerContinuedCount", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		savedSearch := row["savedsearch_name"]
+		if v, ok := row.parseInt("continued", errs); ok {
+			s.mb.RecordSplunkSchedulerContinuedCountDataPoint(ts, v, savedSearch)
+		}
+	}
+}
+
+// Scrape the generation of the indexer cluster and how far behind it each peer is
+func (s *splunkScraper) scrapeClusterGeneration(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkClusterGenerationID.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkClusterPeerGenerationLag.Enabled) || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+	var cg ClusterMasterGeneration
+
+	req, err := s.splunkClient.createAPIRequest(ctx, apiDict[`SplunkClusterMasterGeneration`])
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &cg)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	if len(cg.Entries) == 0 {
+		return
+	}
+	generation := int64(cg.Entries[0].Content.GenerationID)
+	s.mb.RecordSplunkClusterGenerationIDDataPoint(now, generation)
+
+	// the peers are only worth fetching when their lag is reported
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkClusterPeerGenerationLag.Enabled {
+		return
+	}
+
+	var cp ClusterMasterPeers
+
+	req, err = s.splunkClient.createAPIRequest(ctx, apiDict[`SplunkClusterMasterPeers`])
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	peersRes, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer peersRes.Body.Close()
+
+	body, err = io.ReadAll(peersRes.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	err = json.Unmarshal(body, &cp)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	for _, e := range cp.Entries {
+		// peers are identified by their GUID, the label is the name they are known by
+		peer := e.Content.Label
+		if peer == "" {
+			peer = e.Name
+		}
+		s.mb.RecordSplunkClusterPeerGenerationLagDataPoint(now, max(generation-int64(e.Content.BaseGenerationID), 0), peer)
+	}
+}
+
+// Scrape the oplog window and disk usage of the KV store
+func (s *splunkScraper) scrapeKVStoreStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreOplogWindowSeconds.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreDiskUsedBytes.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreMemberUp.Enabled) || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var ks KVStoreStatus
+
+	ept := apiDict[`SplunkKVStoreStatus`]
+
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
 		return
 	}
-	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+	defer res.Body.Close()
 
-	sr := searchResponse{
-		search: searchDict[`SplunkLicenseIndexUsageSearch`],
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
 	}
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
-
-	start := time.Now()
+	err = json.Unmarshal(body, &ks)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
+	for _, e := range ks.Entries {
+		c := e.Content.Current
+		// the oplog is empty, or not reported, while the KV store is starting
+		if c.OplogStartTimestamp > 0 && c.OplogEndTimestamp >= c.OplogStartTimestamp {
+			s.mb.RecordSplunkKvstoreOplogWindowSecondsDataPoint(now, int64(c.OplogEndTimestamp-c.OplogStartTimestamp))
 		}
+		s.mb.RecordSplunkKvstoreDiskUsedBytesDataPoint(now, int64(c.StorageSize))
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
+		// a standalone KV store is its own only member
+		if len(e.Content.Members) == 0 {
+			s.mb.RecordSplunkKvstoreMemberUpDataPoint(now, boolToInt(c.Status == "ready"), c.HostAndPort, c.ReplicationStatus)
+			continue
 		}
-
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
+		for _, m := range e.Content.Members {
+			up := m.ReplicationStatus == "KV store captain" || m.ReplicationStatus == "Non-captain KV store member"
+			s.mb.RecordSplunkKvstoreMemberUpDataPoint(now, boolToInt(up), m.HostAndPort, m.ReplicationStatus)
 		}
-		res.Body.Close()
+	}
+}
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
-		}
+// Scrape the size of each collection of the KV store
+func (s *splunkScraper) scrapeKVStoreCollections(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreCollectionSize.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var cs KVStoreCollectionStats
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	if err := s.getJSON(ctx, apiDict[`SplunkKVStoreCollectionStats`], &cs); err != nil {
+		errs.Add(err)
+		return
 	}
 
-	// Record the results
-	var indexName string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "indexname":
-			indexName = f.Value
-			continue
-		case "By":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
+	for _, e := range cs.Entries {
+		for _, d := range e.Content.Data {
+			var c KVStoreCollection
+			if err := json.Unmarshal([]byte(d), &c); err != nil {
 				errs.Add(err)
 				continue
 			}
-			s.mb.RecordSplunkLicenseIndexUsageDataPoint(now, int64(v), indexName)
+			app, collection, ok := strings.Cut(c.NS, ".")
+			if !ok {
+				continue
+			}
+			s.mb.RecordSplunkKvstoreCollectionSizeDataPoint(now, int64(c.Size), app, collection)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeAvgExecLatencyByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	// Because we have to utilize network resources for each KPI we should check that each metrics
-	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerAvgExecutionLatency.Enabled {
+// Scrape the number of indexes which have not received data within the silent index threshold
+func (s *splunkScraper) scrapeSilentIndexes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexesSilentCount.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	sr := searchResponse{
-		search: searchDict[`SplunkSchedulerAvgExecLatencySearch`],
+	it, err := s.indexesExtended(ctx)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	var silent int64
+	for _, f := range it.Entries {
+		// indexes which never received data have no latest event
+		if f.Content.MaxTime == "" {
+			continue
+		}
+		latest, err := time.Parse(time.RFC3339, f.Content.MaxTime)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		if now.AsTime().Sub(latest) > s.conf.SilentIndexThreshold {
+			silent++
+		}
+	}
+	s.mb.RecordSplunkIndexesSilentCountDataPoint(now, silent)
+}
+
+// Scrape the event count and raw size of each index of a Splunk Cloud stack from the Admin Config Service
+func (s *splunkScraper) scrapeACSIndexes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedEventCount.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedRawSize.Enabled) || !s.splunkClient.isConfigured(typeACS) {
+		return
 	}
-	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	ctx = context.WithValue(ctx, endpointType("type"), typeACS)
 
-	start := time.Now()
+	// ACS does not report the total number of indexes, the last page is the first one that is not full
+	const pageSize = 100
+	for offset := 0; ; offset += pageSize {
+		var page []ACSIndex
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
+		req, err := s.splunkClient.createAPIRequest(ctx, fmt.Sprintf(apiDict[`SplunkACSIndexes`], pageSize, offset))
 		if err != nil {
 			errs.Add(err)
 			return
 		}
 
-		res, err = s.splunkClient.makeRequest(req)
+		res, err := s.splunkClient.makeRequest(req)
 		if err != nil {
 			errs.Add(err)
 			return
 		}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
 		if err != nil {
 			errs.Add(err)
-		}
-		res.Body.Close()
-
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+			return
 		}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			errs.Add(err)
+			return
 		}
 
-		if sr.Return == 400 {
-			break
+		for _, idx := range page {
+			s.mb.RecordSplunkDataIndexesExtendedEventCountDataPoint(now, int64(idx.TotalEventCount), idx.Name)
+			s.mb.RecordSplunkDataIndexesExtendedRawSizeDataPoint(now, int64(idx.TotalRawSizeMB)*1024*1024, idx.Name)
 		}
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
+		if len(page) < pageSize {
 			return
 		}
 	}
+}
+
+// Scrape the events per second read from each pipeline queue. Every event read from a queue is executed
+// once by each processor of the pipeline consuming it, so the busiest processor is counted per metrics sample
+func (s *splunkScraper) scrapeQueueThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkQueueEventsPerSecond.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	sr := searchResponse{
+		name:   `SplunkQueueThroughput`,
+		search: searchDict[`SplunkQueueThroughput`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkQueueThroughput", &sr, errs)
+	s.mapSearchFields("SplunkQueueThroughput", &sr)
 
 	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "latency_avg_exec":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkSchedulerAvgExecutionLatencyDataPoint(now, v, host)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		queue := row["queue"]
+		if v, ok := row.parseFloat("events_per_second", errs); ok {
+			s.mb.RecordSplunkQueueEventsPerSecondDataPoint(ts, v, host, queue)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeIndexerAvgRate(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the seconds indexing was throttled per host. metrics.log samples the queues every 30 seconds, so every
+// sample reporting the index queue as blocked accounts for 30 seconds of throttling
+func (s *splunkScraper) scrapeIndexerThrottledSeconds(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerAvgRate.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThrottledSeconds.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkIndexerAvgRate`],
+		name:   `SplunkIndexerThrottledSeconds`,
+		search: searchDict[`SplunkIndexerThrottledSeconds`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-	start := time.Now()
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerThrottledSeconds", &sr, errs)
+	s.mapSearchFields("SplunkIndexerThrottledSeconds", &sr)
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseInt("throttled_seconds", errs); ok {
+			s.mb.RecordSplunkIndexerThrottledSecondsDataPoint(ts, v, host)
 		}
+	}
+}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+// Scrape the blocked queues indicator of the splunkd health report
+func (s *splunkScraper) scrapeBlockedQueuesHealth(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkHealthBlockedQueues.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+		return
+	}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
-		}
-		res.Body.Close()
+	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
+	var hd HealthDetails
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
-		}
+	ept := apiDict[`SplunkHealthDetails`]
 
-		if sr.Return == 200 {
-			break
-		}
+	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+	res, err := s.splunkClient.makeRequest(req)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	defer res.Body.Close()
 
-		if sr.Return == 400 {
-			break
-		}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	err = json.Unmarshal(body, &hd)
+	if err != nil {
+		errs.Add(err)
+		return
 	}
-	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
+
+	for _, e := range hd.Entries {
+		// the indicator is absent on instances which do not run the indexing pipeline
+		f, ok := e.Content.find("Blocked Queues")
+		if !ok {
+			continue
+		}
+		v, ok := healthColors[f.Health]
+		if !ok {
+			errs.Add(fmt.Errorf("unknown health %q reported for blocked queues", f.Health))
 			continue
-		case "indexer_avg_kbps":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexerAvgRateDataPoint(now, v, host)
 		}
+		s.mb.RecordSplunkHealthBlockedQueuesDataPoint(now, v)
 	}
 }
 
-func (s *splunkScraper) scrapeIndexerPipelineQueues(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	// Because we have to utilize network resources for each KPI we should check that each metrics
-	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkAggregationQueueRatio.Enabled {
+// The colors of the splunkd health report, in increasing severity
+var healthColors = map[string]int64{
+	"green":  0,
+	"yellow": 1,
+	"red":    2,
+}
+
+// Scrape the settings of each index which risk losing or exposing its data: whether its buckets are archived
+// when they roll to frozen rather than deleted, and whether it is shared globally
+func (s *splunkScraper) scrapeIndexConfig(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkIndexFrozenArchiveConfigured.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkIndexSharedGlobally.Enabled ||
+		s.conf.MetricsBuilderConfig.Metrics.SplunkIndexHotBucketsMax.Enabled) || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	sr := searchResponse{
-		search: searchDict[`SplunkPipelineQueues`],
+	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
+
+	entries, err := s.indexConfigEntries(ctx)
+	if err != nil {
+		errs.Add(err)
+		return
 	}
-	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	for _, f := range entries {
+		if f.Name == "" {
+			continue
+		}
+		var configured, global int64
+		if f.Content.ColdToFrozenDir != "" || f.Content.ColdToFrozenScript != "" {
+			configured = 1
+		}
+		if f.ACL.Sharing == "global" {
+			global = 1
+		}
+		s.mb.RecordSplunkIndexFrozenArchiveConfiguredDataPoint(now, configured, f.Name)
+		s.mb.RecordSplunkIndexSharedGloballyDataPoint(now, global, f.Name)
+		if maxHot, ok := parseMaxHotBuckets(f.Content.MaxHotBuckets); ok {
+			s.mb.RecordSplunkIndexHotBucketsMaxDataPoint(now, maxHot, f.Name)
+		}
+	}
+}
 
-	start := time.Now()
+// Reads the maxHotBuckets setting of an index, auto standing for 3 buckets and auto_high_volume for 10
+func parseMaxHotBuckets(v string) (int64, bool) {
+	switch v {
+	case "auto":
+		return 3, true
+	case "auto_high_volume":
+		return 10, true
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	return n, err == nil
+}
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
+// Returns the configuration of every index. It is fetched in full on the first scrape and once every
+// IndexConfigRefreshInterval, and in between only when the lighter indexes-extended listing reveals an index
+// created since. Deleted indexes are forgotten on the next full fetch.
+func (s *splunkScraper) indexConfigEntries(ctx context.Context) ([]IndexesEntry, error) {
+	if s.indexConfig.entries != nil && s.conf.IndexConfigRefreshInterval > 0 && time.Since(s.indexConfig.fetchedAt) < s.conf.IndexConfigRefreshInterval {
+		it, err := s.indexesExtended(ctx)
 		if err != nil {
-			errs.Add(err)
-			return
+			return nil, err
 		}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
+		known := make(map[string]bool, len(s.indexConfig.entries))
+		for _, e := range s.indexConfig.entries {
+			known[e.Name] = true
 		}
-
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
+		created := false
+		for _, e := range it.Entries {
+			if !known[e.Name] {
+				created = true
+				break
+			}
 		}
+		if !created {
+			return s.indexConfig.entries, nil
+		}
+	}
 
-		res.Body.Close()
+	var idx Indexes
+	if err := s.getJSON(ctx, apiDict[`SplunkDataIndexes`], &idx); err != nil {
+		return nil, err
+	}
+	s.indexConfig.entries, s.indexConfig.fetchedAt = idx.Entries, time.Now()
+	return s.indexConfig.entries, nil
+}
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+// Scrape the number of real-time alerts configured on the search head
+func (s *splunkScraper) scrapeRealtimeAlerts(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkAlertsRealtimeCount.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var ss SavedSearches
+
+	if err := s.getJSON(ctx, apiDict[`SplunkSavedSearches`], &ss); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	counts := map[string]int64{"digest": 0, "per_result": 0}
+	for _, f := range ss.Entries {
+		c := f.Content
+		// reports are scheduled saved searches too, only those which trigger something are alerts
+		if c.Disabled || !c.IsScheduled || (!c.AlertTrack && c.Actions == "") {
+			continue
 		}
+		if !strings.HasPrefix(c.EarliestTime, "rt") {
+			continue
+		}
+		if c.DigestMode {
+			counts["digest"]++
+		} else {
+			counts["per_result"]++
+		}
+	}
 
-		if sr.Return == 200 {
-			break
+	for mode, count := range counts {
+		s.mb.RecordSplunkAlertsRealtimeCountDataPoint(now, count, mode)
+	}
+}
+
+// Scrape the number of unexpired triggered alerts of each saved search
+func (s *splunkScraper) scrapeFiredAlerts(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkAlertsFiring.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var fa FiredAlerts
+
+	if err := s.getJSON(ctx, apiDict[`SplunkFiredAlerts`], &fa); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	for _, f := range fa.Entries {
+		if f.Name == "" || f.Name == "-" {
+			continue
 		}
+		s.mb.RecordSplunkAlertsFiringDataPoint(now, int64(f.Content.TriggeredAlertCount), f.Name)
+	}
+}
+
+// Scrape the number of search artifacts in the dispatch directory of the search head waiting to be deleted
+func (s *splunkScraper) scrapeDispatchArtifactsPendingDeletion(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDispatchArtifactsPendingDeletion.Enabled || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var da DispatchArtifacts
+
+	if err := s.getJSON(ctx, apiDict[`SplunkDispatchArtifacts`], &da); err != nil {
+		errs.Add(err)
+		return
+	}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+	for _, e := range da.Entries {
+		s.mb.RecordSplunkDispatchArtifactsPendingDeletionDataPoint(now, int64(e.Content.PendingDeletionCount))
+	}
+}
 
-		if sr.Return == 400 {
-			break
-		}
+// Scrape the number of searches each search peer served. Searches dispatched by a search head are audited
+// on the peers with a search id prefixed by remote_
+func (s *splunkScraper) scrapeIndexerSearchesServed(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerSearchesServed.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	sr := searchResponse{
+		name:   `SplunkIndexerSearchesServed`,
+		search: searchDict[`SplunkIndexerSearchesServed`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
 	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerSearchesServed", &sr, errs)
+	s.mapSearchFields("SplunkIndexerSearchesServed", &sr)
+
 	// Record the results
-	var host string
-	var ps int64
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "agg_queue_ratio":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkAggregationQueueRatioDataPoint(now, v, host)
-		case "index_queue_ratio":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexerQueueRatioDataPoint(now, v, host)
-		case "parse_queue_ratio":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkParseQueueRatioDataPoint(now, v, host)
-		case "pipeline_sets":
-			v, err := strconv.ParseInt(f.Value, 10, 64)
-			ps = v
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkPipelineSetCountDataPoint(now, ps, host)
-		case "typing_queue_ratio":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkTypingQueueRatioDataPoint(now, v, host)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseInt("searches_served", errs); ok {
+			s.mb.RecordSplunkIndexerSearchesServedDataPoint(ts, v, host)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeBucketsSearchableStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the errors logged per index while archiving its buckets as they roll to frozen
+func (s *splunkScraper) scrapeIndexFrozenArchiveFailures(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkBucketsSearchableStatus.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexFrozenArchiveFailures.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkBucketsSearchableStatus`],
+		name:   `SplunkIndexFrozenArchiveFailures`,
+		search: searchDict[`SplunkIndexFrozenArchiveFailures`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-	start := time.Now()
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexFrozenArchiveFailures", &sr, errs)
+	s.mapSearchFields("SplunkIndexFrozenArchiveFailures", &sr)
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		index := row["indexname"]
+		if v, ok := row.parseInt("archive_failures", errs); ok {
+			s.mb.RecordSplunkIndexFrozenArchiveFailuresDataPoint(ts, v, index)
 		}
+	}
+}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
+// Scrape how long splunkd has been running on each configured instance
+func (s *splunkScraper) scrapeServerUptime(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkServerUptimeSeconds.Enabled {
+		return
+	}
+
+	for _, t := range []string{typeIdx, typeSh, typeCm} {
+		if !s.splunkClient.isConfigured(t) {
+			continue
 		}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
+		res, err := s.serverInfo(ctx, t)
 		if err != nil {
 			errs.Add(err)
+			continue
 		}
 
-		res.Body.Close()
-
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
-		}
-
-		if sr.Return == 200 {
-			break
-		}
-
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
-
-		if sr.Return == 400 {
-			break
+		var si ServerInfo
+		if err = json.Unmarshal(res.body, &si); err != nil {
+			errs.Add(err)
+			continue
 		}
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
-	}
-	// Record the results
-	var host string
-	var searchable string
-	var bc int64
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "is_searchable":
-			searchable = f.Value
-			continue
-		case "bucket_count":
-			v, err := strconv.ParseInt(f.Value, 10, 64)
-			bc = v
-			if err != nil {
-				errs.Add(err)
+		for _, e := range si.Entries {
+			if e.Content.StartupTime == 0 {
 				continue
 			}
-			s.mb.RecordSplunkBucketsSearchableStatusDataPoint(now, bc, host, searchable)
+			uptime := now.AsTime().Unix() - int64(e.Content.StartupTime)
+			s.mb.RecordSplunkServerUptimeSecondsDataPoint(now, uptime, e.Content.ServerName)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeIndexesBucketCountAdHoc(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the events dropped because the index they were routed to does not exist, per missing index
+func (s *splunkScraper) scrapeIndexerEventsDroppedNoIndex(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexesSize.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerEventsDroppedNoIndex.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkIndexesData`],
+		name:   `SplunkIndexerEventsDroppedNoIndex`,
+		search: searchDict[`SplunkIndexerEventsDroppedNoIndex`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-	start := time.Now()
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexerEventsDroppedNoIndex", &sr, errs)
+	s.mapSearchFields("SplunkIndexerEventsDroppedNoIndex", &sr)
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		index := row["indexname"]
+		if v, ok := row.parseInt("events_dropped", errs); ok {
+			s.mb.RecordSplunkIndexerEventsDroppedNoIndexDataPoint(ts, v, index)
 		}
+	}
+}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
+// Scrape the number of buckets with a copy being replicated to a peer, streamed for hot buckets and copied
+// whole otherwise
+func (s *splunkScraper) scrapeClusterBucketsReplicating(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkClusterBucketsReplicating.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
+
+	var replicating int64
+	offset := 0
+	for {
+		var page ClusterMasterBuckets
+		if err := s.getJSON(ctx, fmt.Sprintf(apiDict[`SplunkClusterMasterBuckets`], offset), &page); err != nil {
 			errs.Add(err)
 			return
 		}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
+		for _, b := range page.Entries {
+			for _, c := range b.Content.Peers {
+				if c.Status == "StreamingTarget" || c.Status == "NonStreamingTarget" {
+					replicating++
+					break
+				}
+			}
 		}
-		res.Body.Close()
-
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
 
-		if sr.Return == 200 && sr.Jobid != nil {
+		offset += len(page.Entries)
+		if len(page.Entries) == 0 || offset >= page.Paging.Total {
 			break
 		}
+	}
 
-		if sr.Return == 200 {
-			break
-		}
+	s.mb.RecordSplunkClusterBucketsReplicatingDataPoint(now, replicating)
+}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+// Scrape the number of corrupt buckets per index. The cluster master's index listing does not report them, so
+// the buckets are inspected across the peers with dbinspect
+func (s *splunkScraper) scrapeIndexBucketsQuarantined(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkClusterIndexBucketsQuarantined.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
 
-		if sr.Return == 400 {
-			break
-		}
+	sr := searchResponse{
+		name:   `SplunkIndexBucketsQuarantined`,
+		search: searchDict[`SplunkIndexBucketsQuarantined`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
 	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexBucketsQuarantined", &sr, errs)
+	s.mapSearchFields("SplunkIndexBucketsQuarantined", &sr)
+
 	// Record the results
-	var indexer string
-	var bc int64
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "title":
-			indexer = f.Value
-			continue
-		case "total_size_gb":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexesSizeDataPoint(now, v, indexer)
-		case "average_size_gb":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexesAvgSizeDataPoint(now, v, indexer)
-		case "average_usage_perc":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexesAvgUsageDataPoint(now, v, indexer)
-		case "median_data_age":
-			v, err := strconv.ParseInt(f.Value, 10, 64)
-			bc = v
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexesMedianDataAgeDataPoint(now, bc, indexer)
-		case "bucket_count":
-			v, err := strconv.ParseInt(f.Value, 10, 64)
-			bc = v
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexesBucketCountDataPoint(now, bc, indexer)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		index := row["indexname"]
+		if v, ok := row.parseInt("quarantined", errs); ok {
+			s.mb.RecordSplunkClusterIndexBucketsQuarantinedDataPoint(ts, v, index)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeSchedulerCompletionRatioByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the number of events truncated by the line breaker per sourcetype
+func (s *splunkScraper) scrapeIngestionTruncations(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerCompletionRatio.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIngestionTruncations.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkSchedulerCompletionRatio`],
+		name:   `SplunkIngestionTruncations`,
+		search: searchDict[`SplunkIngestionTruncations`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-	start := time.Now()
+	s.scrapeSearchInspection(ctx, now, "SplunkIngestionTruncations", &sr, errs)
+	s.mapSearchFields("SplunkIngestionTruncations", &sr)
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		sourcetype := row["sourcetype"]
+		if v, ok := row.parseInt("truncations", errs); ok {
+			s.mb.RecordSplunkIngestionTruncationsDataPoint(ts, v, sourcetype)
 		}
+	}
+}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+// Scrape whether the replication factor of each index was met at ingest time from the cluster master
+func (s *splunkScraper) scrapeClusterIndexRFMetAtIngest(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkClusterIndexRfMetAtIngest.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
-		}
-		res.Body.Close()
+	var ci ClusterMasterIndexes
+	if err := s.getJSON(context.WithValue(ctx, endpointType("type"), typeCm), apiDict[`SplunkClusterMasterIndexes`], &ci); err != nil {
+		errs.Add(err)
+		return
+	}
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+	for _, e := range ci.Entries {
+		var met int64
+		if e.Content.RFMetAtIngest {
+			met = 1
 		}
+		s.mb.RecordSplunkClusterIndexRfMetAtIngestDataPoint(now, met, e.Name)
+	}
+}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+// Scrape the number of lookups and slow lookups run against each KV store collection
+func (s *splunkScraper) scrapeKVStoreLookups(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreLookupsActive.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkKvstoreLookupsSlow.Enabled) || !s.splunkClient.isConfigured(typeSh) {
+		return
+	}
 
-		if sr.Return == 400 {
-			break
-		}
+	sr := searchResponse{
+		name:   `SplunkKVStoreLookups`,
+		search: searchDict[`SplunkKVStoreLookups`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
 	}
 
+	s.scrapeSearchInspection(ctx, now, "SplunkKVStoreLookups", &sr, errs)
+	s.mapSearchFields("SplunkKVStoreLookups", &sr)
+
 	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "completion_ratio":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkSchedulerCompletionRatioDataPoint(now, v, host)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		app := row["app"]
+		collection := row["collection"]
+		if v, ok := row.parseInt("active", errs); ok {
+			s.mb.RecordSplunkKvstoreLookupsActiveDataPoint(ts, v, app, collection)
+		}
+		if v, ok := row.parseInt("slow", errs); ok {
+			s.mb.RecordSplunkKvstoreLookupsSlowDataPoint(ts, v, app, collection)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeIndexerRawWriteSecondsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the number of searches which ran for longer than the long running search threshold, by user
+func (s *splunkScraper) scrapeLongRunningSearches(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerRawWriteTime.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSearchesLongRunningCount.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkIndexerRawWriteSeconds`],
+		name:   `SplunkLongRunningSearches`,
+		search: searchDict[`SplunkLongRunningSearches`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
-
-	start := time.Now()
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+	s.scrapeSearchInspection(ctx, now, "SplunkLongRunningSearches", &sr, errs)
+	s.mapSearchFields("SplunkLongRunningSearches", &sr)
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		user := row["user"]
+		if v, ok := row.parseInt("long_running", errs); ok {
+			s.mb.RecordSplunkSearchesLongRunningCountDataPoint(ts, v, user)
 		}
+	}
+}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
-		}
-		res.Body.Close()
+// Scrape the number of events indexed per sourcetype. The metadata command lists each sourcetype once
+// for every indexer holding its events, so the counts are summed across the indexers.
+func (s *splunkScraper) scrapeSourcetypeEvents(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSourcetypeEvents.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
-		}
+	sr := searchResponse{
+		name:   `SplunkSourcetypeEvents`,
+		search: searchDict[`SplunkSourcetypeEvents`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-		if sr.Return == 400 {
-			break
-		}
+	s.scrapeSearchInspection(ctx, now, "SplunkSourcetypeEvents", &sr, errs)
+	s.mapSearchFields("SplunkSourcetypeEvents", &sr)
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
+	// Record the results
+	counts := make(map[string]int64)
+	for _, row := range sr.Results {
+		sourcetype := row["sourcetype"]
+		if v, ok := row.parseInt("events", errs); ok {
+			counts[sourcetype] += v
 		}
 	}
 
-	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "raw_data_write_seconds":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexerRawWriteTimeDataPoint(now, v, host)
-		}
+	for sourcetype, count := range counts {
+		s.mb.RecordSplunkSourcetypeEventsDataPoint(now, count, sourcetype)
 	}
 }
 
-func (s *splunkScraper) scrapeIndexerCPUSecondsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the number of times each peer joined and left the indexer cluster
+func (s *splunkScraper) scrapeClusterPeerChurn(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerCPUTime.Enabled {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkClusterPeerJoins.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkClusterPeerLeaves.Enabled) || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkIndexerCpuSeconds`],
+		name:   `SplunkClusterPeerChurn`,
+		search: searchDict[`SplunkClusterPeerChurn`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
-
-	start := time.Now()
-
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+	s.scrapeSearchInspection(ctx, now, "SplunkClusterPeerChurn", &sr, errs)
+	s.mapSearchFields("SplunkClusterPeerChurn", &sr)
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		peer := row["peer"]
+		if v, ok := row.parseInt("joins", errs); ok {
+			s.mb.RecordSplunkClusterPeerJoinsDataPoint(ts, v, peer)
 		}
-		res.Body.Close()
-
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+		if v, ok := row.parseInt("leaves", errs); ok {
+			s.mb.RecordSplunkClusterPeerLeavesDataPoint(ts, v, peer)
 		}
+	}
+}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+// Scrape how long ago each peer of the indexer cluster last replicated a bucket
+func (s *splunkScraper) scrapeClusterBucketSyncLag(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkClusterBucketSyncLagSeconds.Enabled {
+		return
+	}
+	// the peers log to _internal, searchable from the indexer when no cluster master is configured
+	ept := typeCm
+	if !s.splunkClient.isConfigured(ept) {
+		ept = typeIdx
+	}
+	if !s.splunkClient.isConfigured(ept) {
+		return
+	}
 
-		if sr.Return == 400 {
-			break
-		}
+	sr := searchResponse{
+		name:   `SplunkClusterBucketSyncLag`,
+		search: searchDict[`SplunkClusterBucketSyncLag`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), ept)
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
 	}
 
+	s.scrapeSearchInspection(ctx, now, "SplunkClusterBucketSyncLag", &sr, errs)
+	s.mapSearchFields("SplunkClusterBucketSyncLag", &sr)
+
 	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "service_cpu_seconds":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIndexerCPUTimeDataPoint(now, v, host)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		peer := row["peer"]
+		if v, ok := row.parseInt("lag_seconds", errs); ok {
+			s.mb.RecordSplunkClusterBucketSyncLagSecondsDataPoint(ts, v, peer)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeAvgIopsByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the number of searches currently running on the search head
+func (s *splunkScraper) scrapeConcurrentSearches(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIoAvgIops.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSearchesConcurrent.Enabled || !s.splunkClient.isConfigured(typeSh) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkIoAvgIops`],
+		name:   `SplunkConcurrentSearches`,
+		search: searchDict[`SplunkConcurrentSearches`],
 	}
-	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
-
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
-
-	start := time.Now()
-
-	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
 
-		res, err = s.splunkClient.makeRequest(req)
-		if err != nil {
-			errs.Add(err)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
 
-		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
-		if err != nil {
-			errs.Add(err)
-		}
-		res.Body.Close()
+	s.scrapeSearchInspection(ctx, now, "SplunkConcurrentSearches", &sr, errs)
+	s.mapSearchFields("SplunkConcurrentSearches", &sr)
 
-		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
-		// the 200 is coming after the first request which provides a jobId to retrieve results
-		if sr.Return == 200 && sr.Jobid != nil {
-			break
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		if v, ok := row.parseInt("concurrent_searches", errs); ok {
+			s.mb.RecordSplunkSearchesConcurrentDataPoint(ts, v, host)
 		}
+	}
+}
 
-		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
-		}
+// Scrape the number of scheduled searches skipped by each host, by the reason they were skipped for
+func (s *splunkScraper) scrapeSchedulerSkippedCountByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	// Because we have to utilize network resources for each KPI we should check that each metrics
+	// is enabled before proceeding
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerSkippedCount.Enabled || !s.splunkClient.isConfigured(typeCm) {
+		return
+	}
 
-		if sr.Return == 400 {
-			break
-		}
+	sr := searchResponse{
+		name:   `SplunkSchedulerSkippedCount`,
+		search: searchDict[`SplunkSchedulerSkippedCount`],
+	}
+	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
-			return
-		}
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
 	}
 
+	s.scrapeSearchInspection(ctx, now, "SplunkSchedulerSkippedCount", &sr, errs)
+	s.mapSearchFields("SplunkSchedulerSkippedCount", &sr)
+
 	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "iops":
-			v, err := strconv.ParseInt(f.Value, 10, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkIoAvgIopsDataPoint(now, v, host)
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		host := row["host"]
+		reason := row["reason"]
+		if v, ok := row.parseInt("skipped_count", errs); ok {
+			s.mb.RecordSplunkSchedulerSkippedCountDataPoint(ts, v, host, reason)
 		}
 	}
 }
 
-func (s *splunkScraper) scrapeSchedulerRunTimeByHost(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// Scrape the rate of events each indexer indexed into each index
+func (s *splunkScraper) scrapeIndexEventsPerSecond(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	// Because we have to utilize network resources for each KPI we should check that each metrics
 	// is enabled before proceeding
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkSchedulerAvgRunTime.Enabled {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexEventsPerSecond.Enabled || !s.splunkClient.isConfigured(typeCm) {
 		return
 	}
 
 	sr := searchResponse{
-		search: searchDict[`SplunkSchedulerAvgRunTime`],
+		name:   `SplunkIndexEventsPerSecond`,
+		search: searchDict[`SplunkIndexEventsPerSecond`],
 	}
 	ctx = context.WithValue(ctx, endpointType("type"), typeCm)
 
-	var (
-		req *http.Request
-		res *http.Response
-		err error
-	)
+	if err := s.runSearch(ctx, &sr); err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.scrapeSearchInspection(ctx, now, "SplunkIndexEventsPerSecond", &sr, errs)
+	s.mapSearchFields("SplunkIndexEventsPerSecond", &sr)
+
+	// Record the results
+	for _, row := range sr.Results {
+		ts := s.resultTime(row["_time"], now)
+		indexName := row["indexname"]
+		host := row["host"]
+		if v, ok := row.parseFloat("events_per_second", errs); ok {
+			s.mb.RecordSplunkIndexEventsPerSecondDataPoint(ts, v, indexName, host)
+		}
+	}
+}
+
+// Dispatches the search of sr and polls the job until its results are ready, leaving them in sr. Returns an
+// error when a request fails or the results are not ready within the scrape timeout.
+func (s *splunkScraper) runSearch(ctx context.Context, sr *searchResponse) (err error) {
+	if err = s.acquireSearch(ctx); err != nil {
+		return err
+	}
+	defer s.releaseSearch(ctx)
+	defer func() {
+		if err != nil && sr.Jobid != nil {
+			s.cancelSearch(ctx, sr)
+		}
+	}()
 
 	start := time.Now()
+	interval := s.conf.SearchPollInitialInterval
 
 	for {
-		req, err = s.splunkClient.createRequest(ctx, &sr)
+		req, err := s.splunkClient.createRequest(ctx, sr)
 		if err != nil {
-			errs.Add(err)
-			return
+			return err
 		}
 
-		res, err = s.splunkClient.makeRequest(req)
+		res, err := s.splunkClient.makeRequest(req)
 		if err != nil {
-			errs.Add(err)
-			return
+			return err
 		}
 
 		// if its a 204 the body will be empty because we are still waiting on search results
-		err = unmarshallSearchReq(res, &sr)
+		err = unmarshallSearchReq(res, sr)
+		res.Body.Close()
 		if err != nil {
-			errs.Add(err)
+			return err
 		}
-		res.Body.Close()
+
+		s.delayFirstPoll(ctx, sr.name, sr)
 
 		// if no errors and 200 returned scrape was successful, return. Note we must make sure that
 		// the 200 is coming after the first request which provides a jobId to retrieve results
 		if sr.Return == 200 && sr.Jobid != nil {
-			break
+			switch {
+			case s.moreResults(sr):
+				// the next chunk is read right away, the search having completed
+				sr.offset = len(sr.Results)
+			case !s.retryEmptyResults(ctx, sr.name, sr, start):
+				return nil
+			default:
+				interval = s.conf.SearchPollInitialInterval
+			}
 		}
 
 		if sr.Return == 204 {
-			time.Sleep(2 * time.Second)
+			// waiting no longer than the time left, so that the search times out on time
+			wait := min(jitter(interval), s.conf.ScraperControllerSettings.Timeout-time.Since(start))
+			if err := sleepCtx(ctx, wait); err != nil {
+				return err
+			}
+			interval = min(2*interval, s.conf.SearchPollMaxInterval)
 		}
 
-		if sr.Return == 400 {
-			break
+		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
+			return s.searchTimedOut(sr.name, start)
 		}
+	}
+}
 
-		if time.Since(start) > s.conf.ScraperControllerSettings.Timeout {
-			errs.Add(errMaxSearchWaitTimeExceeded)
+// How long deleting an abandoned search job may take
+const searchCancelTimeout = 5 * time.Second
+
+// Deletes a search job abandoned before its results were retrieved, so that it stops running and its
+// artifacts do not pile up in the dispatch directory. The scrape context may be what was cancelled, so the
+// delete gets a context of its own, and its failure is only logged for the search error to be reported.
+func (s *splunkScraper) cancelSearch(ctx context.Context, sr *searchResponse) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), searchCancelTimeout)
+	defer cancel()
+
+	req, err := s.splunkClient.createCancelRequest(ctx, *sr.Jobid)
+	if err == nil {
+		var res *http.Response
+		if res, err = s.splunkClient.makeRequest(req); err == nil {
+			res.Body.Close()
 			return
 		}
 	}
+	s.settings.Logger.Debug("failed to cancel an abandoned search job", zap.String("search_name", sr.name),
+		zap.String("sid", *sr.Jobid), zap.Error(err))
+}
 
-	// Record the results
-	var host string
-	for _, f := range sr.Fields {
-		switch fieldName := f.FieldName; fieldName {
-		case "host":
-			host = f.Value
-			continue
-		case "run_time_avg":
-			v, err := strconv.ParseFloat(f.Value, 64)
-			if err != nil {
-				errs.Add(err)
-				continue
-			}
-			s.mb.RecordSplunkSchedulerAvgRunTimeDataPoint(now, v, host)
-		}
+// Randomizes a poll interval by up to a fifth either way, so that the searches of a scrape, which are
+// dispatched together, do not poll in lockstep
+func jitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * (0.8 + 0.4*rand.Float64())) //nolint:gosec // no need for a secure random number
+}
+
+// Sleeps for d, returning the error of ctx early when it is done before then
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Blocks until a search job can be dispatched to the endpoint in ctx without exceeding
+// max_concurrent_searches. Every successful call must be paired with a call to releaseSearch once the
+// search results have been retrieved.
+func (s *splunkScraper) acquireSearch(ctx context.Context) error {
+	ept, _ := ctx.Value(endpointType("type")).(string)
+	sem, ok := s.searchSems[ept]
+	if !ok {
+		return nil
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Frees the search slot taken by acquireSearch
+func (s *splunkScraper) releaseSearch(ctx context.Context) {
+	ept, _ := ctx.Value(endpointType("type")).(string)
+	sem, ok := s.searchSems[ept]
+	if !ok {
+		return
 	}
+	<-sem
+}
+
+// Reports whether the results of a completed search are read in chunks and the chunk just read was full,
+// so that more results may follow it.
+func (s *splunkScraper) moreResults(sr *searchResponse) bool {
+	return s.conf.ResultsChunkSize > 0 && len(sr.Results)-sr.offset == s.conf.ResultsChunkSize
 }
 
 // Helper function for unmarshaling search endpoint requests
 func unmarshallSearchReq(res *http.Response, sr *searchResponse) error {
 	sr.Return = res.StatusCode
 
-	if res.ContentLength == 0 {
+	// a 204 has no body as the search is still running
+	if res.StatusCode == http.StatusNoContent {
 		return nil
 	}
 
+	// the length of chunked or compressed responses is unknown, and a proxy may report one of 0 along with
+	// a body, so only a body read empty counts as no content
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return fmt.Errorf("Failed to read response: %w", err)
 	}
+	if len(body) == 0 {
+		return nil
+	}
 
 	err = xml.Unmarshal(body, &sr)
 	if err != nil {
 		return fmt.Errorf("Failed to unmarshall response: %w", err)
 	}
 
+	var rows searchRows
+	if err = xml.Unmarshal(body, &rows); err != nil {
+		return fmt.Errorf("Failed to unmarshall response: %w", err)
+	}
+	// the rows of every chunk read so far accumulate in sr
+	for _, result := range rows.Results {
+		row := make(searchRow, len(result.Fields))
+		for _, f := range result.Fields {
+			row[f.FieldName] = f.Value
+		}
+		sr.Results = append(sr.Results, row)
+	}
+
 	return nil
 }
 
-// Scrape index throughput introspection endpoint
-func (s *splunkScraper) scrapeIndexThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Parses the value of a field of a result row as an integer. Reports whether the row has the field and
+// its value parsed, adding the error to errs when it did not.
+func (r searchRow) parseInt(name string, errs *scrapererror.ScrapeErrors) (int64, bool) {
+	value, ok := r[name]
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		errs.Add(err)
+		return 0, false
+	}
+	return v, true
+}
+
+// Parses the value of a field of a result row as a float. Reports whether the row has the field and its
+// value parsed, adding the error to errs when it did not.
+func (r searchRow) parseFloat(name string, errs *scrapererror.ScrapeErrors) (float64, bool) {
+	value, ok := r[name]
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		errs.Add(err)
+		return 0, false
+	}
+	return v, true
+}
+
+// Sleeps once after a search is dispatched when it is configured with an initial result delay, sparing the
+// polls that would only return 204 while a search known to be slow is still running.
+func (s *splunkScraper) delayFirstPoll(ctx context.Context, searchName string, sr *searchResponse) {
+	delay := s.conf.InitialResultDelays[searchName]
+	if delay <= 0 || sr.Return != http.StatusCreated {
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it indexThroughput
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
 
-	ept := apiDict[`SplunkIndexerThroughput`]
+// Prepares a completed search which returned no results to be dispatched again, after the delay it is
+// configured to be retried with when empty, as long as the retry can complete within the scrape timeout.
+// Reports whether the search is to be dispatched again.
+func (s *splunkScraper) retryEmptyResults(ctx context.Context, searchName string, sr *searchResponse, start time.Time) bool {
+	delay, ok := s.conf.EmptyResultRetryDelays[searchName]
+	if !ok || len(sr.Results) > 0 || time.Since(start)+delay >= s.conf.ScraperControllerSettings.Timeout {
+		return false
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+	}
+
+	s.settings.Logger.Debug("search returned no results, dispatching it again", zap.String("search_name", searchName))
+	sr.Jobid = nil
+	sr.Return = 0
+	sr.Results = nil
+	sr.offset = 0
+	return true
+}
+
+// Counts a search which did not complete within the scrape timeout and returns the error reporting it
+func (s *splunkScraper) searchTimedOut(searchName string, start time.Time) error {
+	elapsed := time.Since(start).Round(time.Millisecond)
+	s.stateMu.Lock()
+	s.searchTimeouts[searchName]++
+	s.stateMu.Unlock()
+	s.settings.Logger.Debug("search timed out", zap.String("search_name", searchName), zap.Duration("elapsed", elapsed))
+	return fmt.Errorf("%w %s after %s", errMaxSearchWaitTimeExceeded, searchName, elapsed)
+}
+
+// The timestamp of the data points of a result row: the _time of the row when use_result_time is enabled and
+// it can be parsed, the scrape time otherwise
+func (s *splunkScraper) resultTime(value string, now pcommon.Timestamp) pcommon.Timestamp {
+	if !s.conf.UseResultTime {
+		return now
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// _time is returned as seconds since the epoch when the search does not format it
+		secs, ferr := strconv.ParseFloat(value, 64)
+		if ferr != nil {
+			return now
+		}
+		t = time.Unix(0, int64(secs*float64(time.Second)))
+	}
+	return pcommon.NewTimestampFromTime(t)
+}
+
+// Records the integer state_fields maps each value of the configured string fields of a search to, once
+// per distinct value. Values missing from the mapping are not reported.
+func (s *splunkScraper) recordStateFields(now pcommon.Timestamp, searchName string, sr *searchResponse) {
+	mapping, ok := s.conf.StateFields[searchName]
+	if !ok {
+		return
+	}
+
+	// fields are looked up in a fixed order for the data points to be recorded in one
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	seen := make(map[field]bool)
+	for _, row := range sr.Results {
+		for _, name := range names {
+			f := field{FieldName: name}
+			if f.Value, ok = row[name]; !ok || seen[f] {
+				continue
+			}
+			if state, ok := mapping[name][f.Value]; ok {
+				seen[f] = true
+				s.mb.RecordSplunkReceiverSearchFieldStateDataPoint(now, state, searchName, f.FieldName, f.Value)
+			}
+		}
+	}
+}
+
+// Renames the fields of the search results to the names the scrape functions expect, for deployments
+// where the attribute fields of a search are configured to come from differently named fields.
+func (s *splunkScraper) mapSearchFields(searchName string, sr *searchResponse) {
+	mapping, ok := s.conf.SearchAttributeFields[searchName]
+	if !ok {
+		return
+	}
+
+	renames := make(map[string]string, len(mapping))
+	for attrField, resultField := range mapping {
+		renames[resultField] = attrField
+	}
+	for i, row := range sr.Results {
+		mapped := make(searchRow, len(row))
+		for name, value := range row {
+			if _, ok := renames[name]; !ok {
+				mapped[name] = value
+			}
+		}
+		// a renamed field takes the place of any field of the result already named after the attribute
+		for resultField, attrField := range renames {
+			if value, ok := row[resultField]; ok {
+				mapped[attrField] = value
+			}
+		}
+		sr.Results[i] = mapped
+	}
+}
+
+// Fetch the properties of a finished search job and record how expensive the search was. Requires an
+// additional request per search so it is only done when one of the search inspection metrics is enabled.
+// The number of rows returned is recorded from the results themselves.
+func (s *splunkScraper) scrapeSearchInspection(ctx context.Context, now pcommon.Timestamp, searchName string, sr *searchResponse, errs *scrapererror.ScrapeErrors) {
+	// the job is only worth inspecting when its results were retrieved successfully
+	if sr.Jobid == nil || sr.Return != 200 {
+		return
+	}
+	s.mb.RecordSplunkReceiverSearchRowsDataPoint(now, int64(len(sr.Results)), searchName)
+	s.recordStateFields(now, searchName, sr)
+
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkReceiverSearchScanCount.Enabled &&
+		!s.conf.MetricsBuilderConfig.Metrics.SplunkReceiverSearchEventCount.Enabled &&
+		!s.conf.MetricsBuilderConfig.Metrics.SplunkReceiverSearchResultCount.Enabled {
+		return
+	}
+
+	var jp searchJobProperties
+	ept := fmt.Sprintf(apiDict[`SplunkSearchJobProperties`], url.PathEscape(*sr.Jobid))
 
 	req, err := s.splunkClient.createAPIRequest(ctx, ept)
 	if err != nil {
@@ -1069,26 +3584,29 @@ func (s *splunkScraper) scrapeIndexThroughput(ctx context.Context, now pcommon.T
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	err = json.Unmarshal(body, &jp)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	for _, entry := range it.Entries {
-		s.mb.RecordSplunkIndexerThroughputDataPoint(now, 1000*entry.Content.AvgKb, entry.Content.Status)
+	for _, entry := range jp.Entries {
+		s.mb.RecordSplunkReceiverSearchScanCountDataPoint(now, entry.Content.ScanCount, searchName)
+		s.mb.RecordSplunkReceiverSearchEventCountDataPoint(now, entry.Content.EventCount, searchName)
+		s.mb.RecordSplunkReceiverSearchResultCountDataPoint(now, entry.Content.ResultCount, searchName)
 	}
 }
 
-// Scrape indexes extended total size
-func (s *splunkScraper) scrapeIndexesTotalSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape index throughput introspection endpoint
+func (s *splunkScraper) scrapeIndexThroughput(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerThroughput.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
 	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
-	ept := apiDict[`SplunkDataIndexesExtended`]
+	var it indexThroughput
+
+	ept := apiDict[`SplunkIndexerThroughput`]
 
 	req, err := s.splunkClient.createAPIRequest(ctx, ept)
 	if err != nil {
@@ -1115,68 +3633,58 @@ func (s *splunkScraper) scrapeIndexesTotalSize(ctx context.Context, now pcommon.
 		return
 	}
 
-	var name string
-	var totalSize int64
-	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
-		}
-		if f.Content.TotalSize != "" {
-			mb, err := strconv.ParseFloat(f.Content.TotalSize, 64)
-			totalSize = int64(mb * 1024 * 1024)
-			if err != nil {
-				errs.Add(err)
-			}
-		}
-
-		s.mb.RecordSplunkDataIndexesExtendedTotalSizeDataPoint(now, totalSize, name)
+	for _, entry := range it.Entries {
+		s.mb.RecordSplunkIndexerThroughputDataPoint(now, 1000*entry.Content.AvgKb, entry.Content.Status)
 	}
 }
 
-// Scrape indexes extended total event count
-func (s *splunkScraper) scrapeIndexesEventCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedEventCount.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape indexes extended total size
+func (s *splunkScraper) scrapeIndexesTotalSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
-
-	ept := apiDict[`SplunkDataIndexesExtended`]
-
-	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	res, err := s.splunkClient.makeRequest(req)
-	if err != nil {
-		errs.Add(err)
-		return
+	// entries missing the name or the value are skipped rather than reported under another index
+	for _, f := range it.Entries {
+		if f.Name == "" || f.Content.TotalSize == "" {
+			continue
+		}
+		mb, err := strconv.ParseFloat(f.Content.TotalSize, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		s.mb.RecordSplunkDataIndexesExtendedTotalSizeDataPoint(now, int64(mb*1024*1024), f.Name)
 	}
-	defer res.Body.Close()
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		errs.Add(err)
+// Scrape indexes extended total event count
+func (s *splunkScraper) scrapeIndexesEventCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedEventCount.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkIndexerEventsIndexed.Enabled) || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	var name string
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
+		if f.Name == "" {
+			continue
 		}
 		totalEventCount := int64(f.Content.TotalEventCount)
 
-		s.mb.RecordSplunkDataIndexesExtendedEventCountDataPoint(now, totalEventCount, name)
+		s.mb.RecordSplunkDataIndexesExtendedEventCountDataPoint(now, totalEventCount, f.Name)
+		s.mb.RecordSplunkIndexerEventsIndexedDataPoint(now, totalEventCount, f.Name)
 	}
 }
 
@@ -1186,186 +3694,264 @@ func (s *splunkScraper) scrapeIndexesBucketCount(ctx context.Context, now pcommo
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
-
-	ept := apiDict[`SplunkDataIndexesExtended`]
-
-	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	res, err := s.splunkClient.makeRequest(req)
-	if err != nil {
-		errs.Add(err)
+	// entries missing the name or the value are skipped rather than reported under another index
+	for _, f := range it.Entries {
+		if f.Name == "" || f.Content.TotalBucketCount == "" {
+			continue
+		}
+		totalBucketCount, err := strconv.ParseInt(f.Content.TotalBucketCount, 10, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		s.mb.RecordSplunkDataIndexesExtendedBucketCountDataPoint(now, totalBucketCount, f.Name)
+	}
+}
+
+// Scrape indexes extended raw size
+func (s *splunkScraper) scrapeIndexesRawSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedRawSize.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	// entries missing the name or the value are skipped rather than reported under another index
+	for _, f := range it.Entries {
+		if f.Name == "" || f.Content.TotalRawSize == "" {
+			continue
+		}
+		mb, err := strconv.ParseFloat(f.Content.TotalRawSize, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		s.mb.RecordSplunkDataIndexesExtendedRawSizeDataPoint(now, int64(mb*1024*1024), f.Name)
+	}
+}
+
+// Scrape indexes extended bucket event count
+func (s *splunkScraper) scrapeIndexesBucketEventCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedBucketEventCount.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+		return
+	}
+
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	var name string
-	var totalBucketCount int64
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
+		if f.Name == "" {
+			continue
 		}
-		if f.Content.TotalBucketCount != "" {
-			totalBucketCount, err = strconv.ParseInt(f.Content.TotalBucketCount, 10, 64)
+		dirs := f.Content.BucketDirs
+		for _, dir := range []struct {
+			name       string
+			eventCount string
+		}{
+			{"cold", dirs.Cold.EventCount},
+			{"home", dirs.Home.EventCount},
+			{"thawed", dirs.Thawed.EventCount},
+		} {
+			if dir.eventCount == "" {
+				continue
+			}
+			bucketEventCount, err := strconv.ParseInt(dir.eventCount, 10, 64)
 			if err != nil {
 				errs.Add(err)
+				continue
 			}
+			s.mb.RecordSplunkDataIndexesExtendedBucketEventCountDataPoint(now, bucketEventCount, f.Name, dir.name)
 		}
-
-		s.mb.RecordSplunkDataIndexesExtendedBucketCountDataPoint(now, totalBucketCount, name)
 	}
 }
 
-// Scrape indexes extended raw size
-func (s *splunkScraper) scrapeIndexesRawSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedRawSize.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape indexes extended bucket hot/warm count
+func (s *splunkScraper) scrapeIndexesBucketHotWarmCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedBucketHotCount.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkIndexHotBucketsCurrent.Enabled) || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
-
-	ept := apiDict[`SplunkDataIndexesExtended`]
-
-	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	res, err := s.splunkClient.makeRequest(req)
-	if err != nil {
-		errs.Add(err)
-		return
+	for _, f := range it.Entries {
+		if f.Name == "" {
+			continue
+		}
+		if f.Content.BucketDirs.Home.HotBucketCount != "" {
+			if bucketHotCount, err := strconv.ParseInt(f.Content.BucketDirs.Home.HotBucketCount, 10, 64); err != nil {
+				errs.Add(err)
+			} else {
+				s.mb.RecordSplunkDataIndexesExtendedBucketHotCountDataPoint(now, bucketHotCount, f.Name, "hot")
+				s.mb.RecordSplunkIndexHotBucketsCurrentDataPoint(now, bucketHotCount, f.Name)
+			}
+		}
+		if f.Content.BucketDirs.Home.WarmBucketCount != "" {
+			if bucketWarmCount, err := strconv.ParseInt(f.Content.BucketDirs.Home.WarmBucketCount, 10, 64); err != nil {
+				errs.Add(err)
+			} else {
+				s.mb.RecordSplunkDataIndexesExtendedBucketWarmCountDataPoint(now, bucketWarmCount, f.Name, "warm")
+			}
+		}
 	}
-	defer res.Body.Close()
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		errs.Add(err)
+// Scrape the size of the hot, warm and cold buckets of each index, reported in MB by indexes-extended
+func (s *splunkScraper) scrapeIndexesSizeByState(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	m := s.conf.MetricsBuilderConfig.Metrics
+	if !(m.SplunkIndexSizeHotBytes.Enabled || m.SplunkIndexSizeWarmBytes.Enabled || m.SplunkIndexSizeColdBytes.Enabled) || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	var name string
-	var totalRawSize int64
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
+		if f.Name == "" {
+			continue
 		}
-		if f.Content.TotalRawSize != "" {
-			mb, err := strconv.ParseFloat(f.Content.TotalRawSize, 64)
-			totalRawSize = int64(mb * 1024 * 1024)
+		dirs := f.Content.BucketDirs
+		for _, size := range []struct {
+			mb     string
+			record func(pcommon.Timestamp, int64, string)
+		}{
+			{dirs.Home.HotBucketSize, s.mb.RecordSplunkIndexSizeHotBytesDataPoint},
+			{dirs.Home.WarmBucketSize, s.mb.RecordSplunkIndexSizeWarmBytesDataPoint},
+			{dirs.Cold.BucketSize, s.mb.RecordSplunkIndexSizeColdBytesDataPoint},
+		} {
+			if size.mb == "" {
+				continue
+			}
+			mb, err := strconv.ParseFloat(size.mb, 64)
 			if err != nil {
 				errs.Add(err)
+				continue
 			}
+			size.record(now, int64(mb*1024*1024), f.Name)
 		}
-		s.mb.RecordSplunkDataIndexesExtendedRawSizeDataPoint(now, totalRawSize, name)
 	}
 }
 
-// Scrape indexes extended bucket event count
-func (s *splunkScraper) scrapeIndexesBucketEventCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedBucketEventCount.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape the warm bucket limit of each index and how close the index is to it
+func (s *splunkScraper) scrapeIndexBucketUtilization(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !(s.conf.MetricsBuilderConfig.Metrics.SplunkIndexMaxBuckets.Enabled || s.conf.MetricsBuilderConfig.Metrics.SplunkIndexBucketUtilizationRatio.Enabled) || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
-
-	ept := apiDict[`SplunkDataIndexesExtended`]
-
-	req, err := s.splunkClient.createAPIRequest(ctx, ept)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	res, err := s.splunkClient.makeRequest(req)
-	if err != nil {
-		errs.Add(err)
-		return
+	for _, f := range it.Entries {
+		// indexes without a warm bucket limit cannot run into it
+		if f.Content.MaxWarmDBCount <= 0 {
+			continue
+		}
+		s.mb.RecordSplunkIndexMaxBucketsDataPoint(now, f.Content.MaxWarmDBCount, f.Name)
+
+		if f.Content.BucketDirs.Home.WarmBucketCount == "" {
+			continue
+		}
+		warm, err := strconv.ParseInt(f.Content.BucketDirs.Home.WarmBucketCount, 10, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		s.mb.RecordSplunkIndexBucketUtilizationRatioDataPoint(now, float64(warm)/float64(f.Content.MaxWarmDBCount), f.Name)
 	}
-	defer res.Body.Close()
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		errs.Add(err)
+// Scrape indexes extended projected days until each index hits its size cap
+func (s *splunkScraper) scrapeIndexesDaysUntilFull(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkIndexDaysUntilFull.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	it, err := s.indexesExtended(ctx)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
 
-	var name string
-	var bucketDir string
-	var bucketEventCount int64
+	// only keep the indexes present in this response so deleted or renamed indexes are forgotten
+	prevSizes := maps.Clone(s.indexSizes)
+	clear(s.indexSizes)
+
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
+		if f.Name == "" || f.Content.TotalSize == "" {
+			continue
 		}
-		if f.Content.BucketDirs.Cold.EventCount != "" {
-			bucketDir = "cold"
-			bucketEventCount, err = strconv.ParseInt(f.Content.BucketDirs.Cold.EventCount, 10, 64)
-			if err != nil {
-				errs.Add(err)
-			}
-			s.mb.RecordSplunkDataIndexesExtendedBucketEventCountDataPoint(now, bucketEventCount, name, bucketDir)
+		sizeMB, err := strconv.ParseFloat(f.Content.TotalSize, 64)
+		if err != nil {
+			errs.Add(err)
+			continue
 		}
-		if f.Content.BucketDirs.Home.EventCount != "" {
-			bucketDir = "home"
-			bucketEventCount, err = strconv.ParseInt(f.Content.BucketDirs.Home.EventCount, 10, 64)
-			if err != nil {
-				errs.Add(err)
-			}
-			s.mb.RecordSplunkDataIndexesExtendedBucketEventCountDataPoint(now, bucketEventCount, name, bucketDir)
+
+		// the growth rate can only be estimated once we have seen the index on a previous scrape
+		current := indexSizeSample{sizeMB: sizeMB, ts: now.AsTime()}
+		prev, ok := prevSizes[f.Name]
+		s.indexSizes[f.Name] = current
+		if !ok {
+			continue
 		}
-		if f.Content.BucketDirs.Thawed.EventCount != "" {
-			bucketDir = "thawed"
-			bucketEventCount, err = strconv.ParseInt(f.Content.BucketDirs.Thawed.EventCount, 10, 64)
-			if err != nil {
-				errs.Add(err)
-			}
-			s.mb.RecordSplunkDataIndexesExtendedBucketEventCountDataPoint(now, bucketEventCount, name, bucketDir)
+
+		elapsedDays := current.ts.Sub(prev.ts).Hours() / 24
+		if elapsedDays <= 0 {
+			continue
+		}
+		growthMBPerDay := (current.sizeMB - prev.sizeMB) / elapsedDays
+
+		if days, ok := daysUntilFull(current.sizeMB, float64(f.Content.MaxTotalDataSize), growthMBPerDay); ok {
+			s.mb.RecordSplunkIndexDaysUntilFullDataPoint(now, days, f.Name)
 		}
 	}
 }
 
-// Scrape indexes extended bucket hot/warm count
-func (s *splunkScraper) scrapeIndexesBucketHotWarmCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkDataIndexesExtendedBucketHotCount.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Returns the number of days until an index of sizeMB reaches capMB when growing at growthMBPerDay.
+// The projection is only meaningful for a capped index that is growing, otherwise false is returned.
+func daysUntilFull(sizeMB, capMB, growthMBPerDay float64) (float64, bool) {
+	if capMB <= 0 || growthMBPerDay <= 0 {
+		return 0, false
+	}
+	if sizeMB >= capMB {
+		return 0, true
+	}
+	return (capMB - sizeMB) / growthMBPerDay, true
+}
+
+// Scrape introspection queues
+func (s *splunkScraper) scrapeIntrospectionQueues(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkServerIntrospectionQueuesCurrent.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
 	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IndexesExtended
+	var it IntrospectionQueues
 
-	ept := apiDict[`SplunkDataIndexesExtended`]
+	ept := apiDict[`SplunkIntrospectionQueues`]
 
 	req, err := s.splunkClient.createAPIRequest(ctx, ept)
 	if err != nil {
@@ -1392,36 +3978,20 @@ func (s *splunkScraper) scrapeIndexesBucketHotWarmCount(ctx context.Context, now
 		return
 	}
 
-	var name string
-	var bucketDir string
-	var bucketHotCount int64
-	var bucketWarmCount int64
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
-		}
-		if f.Content.BucketDirs.Home.HotBucketCount != "" {
-			bucketHotCount, err = strconv.ParseInt(f.Content.BucketDirs.Home.HotBucketCount, 10, 64)
-			bucketDir = "hot"
-			if err != nil {
-				errs.Add(err)
-			}
-			s.mb.RecordSplunkDataIndexesExtendedBucketHotCountDataPoint(now, bucketHotCount, name, bucketDir)
-		}
-		if f.Content.BucketDirs.Home.WarmBucketCount != "" {
-			bucketWarmCount, err = strconv.ParseInt(f.Content.BucketDirs.Home.WarmBucketCount, 10, 64)
-			bucketDir = "warm"
-			if err != nil {
-				errs.Add(err)
-			}
-			s.mb.RecordSplunkDataIndexesExtendedBucketWarmCountDataPoint(now, bucketWarmCount, name, bucketDir)
+		if f.Name == "" {
+			continue
 		}
+
+		currentQueuesSize := int64(f.Content.CurrentSize)
+
+		s.mb.RecordSplunkServerIntrospectionQueuesCurrentDataPoint(now, currentQueuesSize, f.Name)
 	}
 }
 
-// Scrape introspection queues
-func (s *splunkScraper) scrapeIntrospectionQueues(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkServerIntrospectionQueuesCurrent.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape introspection queues bytes
+func (s *splunkScraper) scrapeIntrospectionQueuesBytes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkServerIntrospectionQueuesCurrentBytes.Enabled || !s.splunkClient.isConfigured(typeIdx) {
 		return
 	}
 
@@ -1454,29 +4024,86 @@ func (s *splunkScraper) scrapeIntrospectionQueues(ctx context.Context, now pcomm
 		errs.Add(err)
 		return
 	}
-
-	var name string
 	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
+		if f.Name == "" {
+			continue
 		}
 
-		currentQueuesSize := int64(f.Content.CurrentSize)
+		currentQueueSizeBytes := int64(f.Content.CurrentSizeBytes)
 
-		s.mb.RecordSplunkServerIntrospectionQueuesCurrentDataPoint(now, currentQueuesSize, name)
+		s.mb.RecordSplunkServerIntrospectionQueuesCurrentBytesDataPoint(now, currentQueueSizeBytes, f.Name)
 	}
 }
 
-// Scrape introspection queues bytes
-func (s *splunkScraper) scrapeIntrospectionQueuesBytes(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
-	if !s.conf.MetricsBuilderConfig.Metrics.SplunkServerIntrospectionQueuesCurrentBytes.Enabled || !s.splunkClient.isConfigured(typeIdx) {
+// Scrape the number of lookup table files and KV store collections per app
+func (s *splunkScraper) scrapeLookupCount(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkLookupCount.Enabled || !s.splunkClient.isConfigured(typeSh) || s.skipClusterScope {
 		return
 	}
 
-	ctx = context.WithValue(ctx, endpointType("type"), typeIdx)
-	var it IntrospectionQueues
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	counts := make(map[string]int64)
 
-	ept := apiDict[`SplunkIntrospectionQueues`]
+	for _, ept := range []string{apiDict[`SplunkLookupTableFiles`], apiDict[`SplunkKVStoreCollections`]} {
+		if err := s.countLookupsByApp(ctx, ept, counts); err != nil {
+			errs.Add(err)
+			return
+		}
+	}
+
+	for app, count := range counts {
+		s.mb.RecordSplunkLookupCountDataPoint(now, count, app)
+	}
+}
+
+// Walk every page of a lookup listing endpoint and add the number of entries found for each app to counts
+func (s *splunkScraper) countLookupsByApp(ctx context.Context, ept string, counts map[string]int64) error {
+	offset := 0
+	for {
+		var page lookups
+
+		req, err := s.splunkClient.createAPIRequest(ctx, fmt.Sprintf(ept, offset))
+		if err != nil {
+			return err
+		}
+
+		res, err := s.splunkClient.makeRequest(req)
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range page.Entries {
+			counts[e.ACL.App]++
+		}
+
+		offset += len(page.Entries)
+		if len(page.Entries) == 0 || offset >= page.Paging.Total {
+			return nil
+		}
+	}
+}
+
+// Scrape the size of each KV store collection
+func (s *splunkScraper) scrapeLookupSize(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SplunkLookupSizeBytes.Enabled || !s.splunkClient.isConfigured(typeSh) || s.skipClusterScope {
+		return
+	}
+
+	ctx = context.WithValue(ctx, endpointType("type"), typeSh)
+	var kvs kvStoreStats
+
+	ept := apiDict[`SplunkKVStoreStats`]
 
 	req, err := s.splunkClient.createAPIRequest(ctx, ept)
 	if err != nil {
@@ -1497,19 +4124,25 @@ func (s *splunkScraper) scrapeIntrospectionQueuesBytes(ctx context.Context, now
 		return
 	}
 
-	err = json.Unmarshal(body, &it)
+	err = json.Unmarshal(body, &kvs)
 	if err != nil {
 		errs.Add(err)
 		return
 	}
-	var name string
-	for _, f := range it.Entries {
-		if f.Name != "" {
-			name = f.Name
-		}
 
-		currentQueueSizeBytes := int64(f.Content.CurrentSizeBytes)
+	for _, entry := range kvs.Entries {
+		for _, d := range entry.Content.Data {
+			var cs kvStoreCollectionStats
+			if err = json.Unmarshal([]byte(d), &cs); err != nil {
+				errs.Add(err)
+				continue
+			}
 
-		s.mb.RecordSplunkServerIntrospectionQueuesCurrentBytesDataPoint(now, currentQueueSizeBytes, name)
+			app, collection, ok := strings.Cut(cs.NS, ".")
+			if !ok {
+				continue
+			}
+			s.mb.RecordSplunkLookupSizeBytesDataPoint(now, cs.Size, app, collection)
+		}
 	}
 }
@@ -5,19 +5,34 @@ package splunkenterprisereceiver // import "github.com/open-telemetry/openteleme
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -47,6 +62,13 @@ func mockIntrospectionQueues(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/server/introspection/queues","updated":"2023-09-18T13:37:45+00:00","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"AEQ","id":"https://somehost:8089/services/server/introspection/queues/AEQ","updated":"1970-01-01T00:00:00+00:00","links":{"alternate":"/services/server/introspection/queues/AEQ","list":"/services/server/introspection/queues/AEQ","edit":"/services/server/introspection/queues/AEQ"},"author":"system","acl":{"app":"","can_list":true,"can_write":true,"modifiable":false,"owner":"system","perms":{"read":["admin","splunk-system-role"],"write":["admin","splunk-system-role"]},"removable":false,"sharing":"system"},"content":{"cntr_1_lookback_time":60,"cntr_2_lookback_time":600,"cntr_3_lookback_time":900,"current_size":1,"current_size_bytes":100,"eai:acl":null,"largest_size":3,"max_size_bytes":512000,"sampling_interval":1,"smallest_size":0,"value_cntr1_size_bytes_lookback":0,"value_cntr1_size_lookback":0,"value_cntr2_size_bytes_lookback":0,"value_cntr2_size_lookback":0,"value_cntr3_size_bytes_lookback":0,"value_cntr3_size_lookback":0}}],"paging":{"total":13,"perPage":1,"offset":0},"messages":[]}`))
 }
 
+func mockSearchJobProperties(w http.ResponseWriter, _ *http.Request) {
+	status := http.StatusOK
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"links":{},"origin":"https://somehost:8089/services/search/jobs","updated":"2023-09-18T13:37:45+00:00","generator":{"build":"82c987350fde","version":"9.0.1"},"entry":[{"name":"search index=_internal","id":"https://somehost:8089/services/search/jobs/123","updated":"2023-09-18T13:37:45.000+00:00","links":{},"author":"admin","content":{"dispatchState":"DONE","doneProgress":1,"eventCount":1532,"isDone":true,"isFailed":false,"resultCount":4,"runDuration":0.512,"scanCount":88731,"sid":"123"}}],"paging":{"total":1,"perPage":0,"offset":0},"messages":[]}`))
+}
+
 // mock server create
 func createMockServer() *httptest.Server {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -57,6 +79,8 @@ func createMockServer() *httptest.Server {
 			mockIndexesExtended(w, r)
 		case "/services/server/introspection/queues?output_mode=json&count=-1":
 			mockIntrospectionQueues(w, r)
+		case "/services/search/jobs/123?output_mode=json":
+			mockSearchJobProperties(w, r)
 		default:
 			http.NotFoundHandler().ServeHTTP(w, r)
 		}
@@ -100,7 +124,11 @@ func TestScraper(t *testing.T) {
 			InitialDelay:       1 * time.Second,
 			Timeout:            11 * time.Second,
 		},
-		MetricsBuilderConfig: metricsettings,
+		MetricsBuilderConfig:      metricsettings,
+		SearchPollInitialInterval: 2 * time.Second,
+		SearchPollMaxInterval:     2 * time.Second,
+		// the mock server only knows the _audit index
+		IncludeInternalIndexes: true,
 	}
 
 	host := &mockHost{
@@ -126,3 +154,3209 @@ func TestScraper(t *testing.T) {
 
 	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
+
+// mock server for ad-hoc searches. Dispatching a search returns a job id and fetching the results of the
+// job returns the given results document
+func createMockSearchServer(results string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(results))
+	}))
+}
+
+// creates a mock search server like createMockSearchServer, which also keeps the search each job is dispatched
+// with in search, form decoded the way Splunk reads it
+func createRecordingSearchServer(results string, search *atomic.Value) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			form, _ := url.ParseQuery(string(body))
+			search.Store(form.Get("search"))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(results))
+	}))
+}
+
+// creates a config pointing only the given endpoint type at the mock server
+func createMockConfig(ept string, endpoint string, metricsettings metadata.MetricsBuilderConfig) *Config {
+	clientCfg := confighttp.ClientConfig{
+		Endpoint: endpoint,
+		Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+	}
+
+	cfg := &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 10 * time.Second,
+			InitialDelay:       1 * time.Second,
+			Timeout:            11 * time.Second,
+		},
+		MetricsBuilderConfig: metricsettings,
+	}
+
+	switch ept {
+	case typeIdx:
+		cfg.IdxEndpoint = clientCfg
+	case typeSh:
+		cfg.SHEndpoint = clientCfg
+	case typeCm:
+		cfg.CMEndpoint = clientCfg
+	case typeACS:
+		cfg.ACSEndpoint = clientCfg
+	}
+	return cfg
+}
+
+// creates a scraper with a started client for the given config
+func createMockScraper(t *testing.T, cfg *Config) splunkScraper {
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	scraper.splunkClient = client
+
+	return scraper
+}
+
+// collects the data points of every emitted gauge keyed by metric name and the value of the given attribute
+func emittedGauges(t *testing.T, scraper *splunkScraper, attr string) map[string]map[string]pcommon.Value {
+	out := map[string]map[string]pcommon.Value{}
+	rms := scraper.mb.Emit().ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				out[m.Name()] = map[string]pcommon.Value{}
+				dps := m.Gauge().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					// metrics without attributes are keyed by the empty string
+					key := pcommon.NewValueStr("")
+					if attr != "" {
+						var ok bool
+						key, ok = dp.Attributes().Get(attr)
+						require.True(t, ok, "metric %s is missing attribute %s", m.Name(), attr)
+					}
+					if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+						out[m.Name()][key.AsString()] = pcommon.NewValueInt(dp.IntValue())
+					} else {
+						out[m.Name()][key.AsString()] = pcommon.NewValueDouble(dp.DoubleValue())
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+func TestScrapeSearchInspection(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkReceiverSearchScanCount.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchEventCount.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchResultCount.Enabled = true
+
+	cfg := &Config{
+		CMEndpoint: confighttp.ClientConfig{
+			Endpoint: ts.URL,
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 10 * time.Second,
+			InitialDelay:       1 * time.Second,
+			Timeout:            11 * time.Second,
+		},
+		MetricsBuilderConfig: metricsettings,
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	scraper.splunkClient = client
+
+	jobID := "123"
+	sr := searchResponse{Jobid: &jobID, Return: 200}
+	errs := &scrapererror.ScrapeErrors{}
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeCm)
+
+	scraper.scrapeSearchInspection(ctx, pcommon.NewTimestampFromTime(time.Now()), "SplunkIndexerAvgRate", &sr, errs)
+	require.NoError(t, errs.Combine())
+
+	expected := map[string]int64{
+		"splunk.receiver.search.scan_count":   88731,
+		"splunk.receiver.search.event_count":  1532,
+		"splunk.receiver.search.result_count": 4,
+	}
+
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, len(expected), ms.Len())
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		dp := m.Gauge().DataPoints().At(0)
+		require.Equal(t, expected[m.Name()], dp.IntValue())
+		name, ok := dp.Attributes().Get("search_name")
+		require.True(t, ok)
+		require.Equal(t, "SplunkIndexerAvgRate", name.Str())
+	}
+}
+
+func TestScrapeSearchInspectionEscapesJobID(t *testing.T) {
+	var path, query atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path.Store(r.URL.Path)
+		query.Store(r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"entry":[{"content":{"scanCount":1,"eventCount":1,"resultCount":1}}]}`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkReceiverSearchScanCount.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	scraper.splunkClient = client
+
+	// the job id is built into the path the same way as when cancelling the job
+	jobID := "admin__admin__search__1712.5?x"
+	sr := searchResponse{Jobid: &jobID, Return: 200}
+	errs := &scrapererror.ScrapeErrors{}
+	ctx := context.WithValue(context.Background(), endpointType("type"), typeCm)
+
+	scraper.scrapeSearchInspection(ctx, pcommon.NewTimestampFromTime(time.Now()), "SplunkIndexerAvgRate", &sr, errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, "/services/search/jobs/admin__admin__search__1712.5?x", path.Load())
+	require.Equal(t, "output_mode=json", query.Load())
+}
+
+func TestDaysUntilFull(t *testing.T) {
+	tests := []struct {
+		desc     string
+		sizeMB   float64
+		capMB    float64
+		growth   float64
+		expected float64
+		ok       bool
+	}{
+		{desc: "growing index", sizeMB: 400, capMB: 1000, growth: 50, expected: 12, ok: true},
+		{desc: "index already full", sizeMB: 1200, capMB: 1000, growth: 50, expected: 0, ok: true},
+		{desc: "shrinking index", sizeMB: 400, capMB: 1000, growth: -5, ok: false},
+		{desc: "index without a cap", sizeMB: 400, capMB: 0, growth: 50, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			days, ok := daysUntilFull(test.sizeMB, test.capMB, test.growth)
+			require.Equal(t, test.ok, ok)
+			require.InDelta(t, test.expected, days, 0.0001)
+		})
+	}
+}
+
+func TestScrapeIndexesDaysUntilFull(t *testing.T) {
+	ts := createMockServer()
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexDaysUntilFull.Enabled = true
+
+	cfg := &Config{
+		IdxEndpoint: confighttp.ClientConfig{
+			Endpoint: ts.URL,
+			Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+		},
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 10 * time.Second,
+			InitialDelay:       1 * time.Second,
+			Timeout:            11 * time.Second,
+		},
+		MetricsBuilderConfig: metricsettings,
+	}
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	client, err := newSplunkEntClient(cfg, host, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	scraper.splunkClient = client
+
+	now := time.Now()
+	errs := &scrapererror.ScrapeErrors{}
+
+	// first scrape only records the size of the index
+	scraper.scrapeIndexesDaysUntilFull(context.Background(), pcommon.NewTimestampFromTime(now), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, 0, scraper.mb.Emit().MetricCount())
+
+	// pretend the index grew by 100MB over the last day, the mock index is 19854.039MB of a 500000MB cap
+	scraper.indexSizes["_audit"] = indexSizeSample{sizeMB: 19754.039, ts: now.Add(-24 * time.Hour)}
+	// an index which no longer exists should be forgotten
+	scraper.indexSizes["deleted"] = indexSizeSample{sizeMB: 10, ts: now.Add(-24 * time.Hour)}
+	scraper.scrapeIndexesDaysUntilFull(context.Background(), pcommon.NewTimestampFromTime(now), errs)
+	require.NoError(t, errs.Combine())
+	require.NotContains(t, scraper.indexSizes, "deleted")
+
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, ms.Len())
+	require.Equal(t, "splunk.index.days_until_full", ms.At(0).Name())
+	require.InDelta(t, (500000-19854.039)/100, ms.At(0).Gauge().DataPoints().At(0).DoubleValue(), 0.0001)
+}
+
+func TestMaxConcurrentSearches(t *testing.T) {
+	const (
+		maxSearches = 2
+		receivers   = 3
+		searches    = 3
+	)
+	var (
+		mu        sync.Mutex
+		active    int
+		maxActive int
+		jobs      int
+	)
+
+	// every dispatched search stays outstanding until its results have been fetched
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the server roles fetched on start
+		if r.URL.Path == "/services/server/info" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodPost {
+			mu.Lock()
+			jobs++
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			sid := jobs
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `<response><sid>%d</sid></response>`, sid)
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<results preview="0"></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerAvgRunTime.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerCompletionRatio.Enabled = true
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	// the searches of a single scrape are dispatched one after another so the limit only comes into play
+	// when several receivers target the same endpoint
+	var wg sync.WaitGroup
+	for i := 0; i < receivers; i++ {
+		cfg := &Config{
+			CMEndpoint: confighttp.ClientConfig{
+				Endpoint: ts.URL,
+				Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewIDWithName("basicauth", "client")},
+			},
+			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+				CollectionInterval: 10 * time.Second,
+				InitialDelay:       1 * time.Second,
+				Timeout:            11 * time.Second,
+			},
+			MetricsBuilderConfig:  metricsettings,
+			MaxConcurrentSearches: maxSearches,
+		}
+
+		scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, scraper.start(context.Background(), host))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := scraper.scrape(context.Background())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, receivers*searches, jobs)
+	require.Greater(t, maxActive, 1)
+	require.LessOrEqual(t, maxActive, maxSearches)
+}
+
+func TestScrapeIndexBucketActivity(t *testing.T) {
+	// results of the search over splunkd.log messages such as
+	//   INFO HotBucketRoller - finished moving hot to warm bid=main~12~... idx=main from=hot_v1_12 to=db_... caller=size_exceeded
+	// for rolls and the BucketMerger messages logged for each merged bucket on the same index
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>bucket_merges</field><field>bucket_rolls</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>main</text></value></field><field k="bucket_merges"><value><text>3</text></value></field><field k="bucket_rolls"><value><text>7</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexBucketMerges.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketRolls.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexBucketActivity(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(3), metrics["splunk.index.bucket_merges"]["main"].Int())
+	require.Equal(t, int64(7), metrics["splunk.index.bucket_rolls"]["main"].Int())
+}
+
+func TestScrapeLookups(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// lookup table files are split over two pages
+		case "/services/data/lookup-table-files?output_mode=json&count=100&offset=0":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"geo.csv","acl":{"app":"search"}},{"name":"assets.csv","acl":{"app":"SplunkEnterpriseSecuritySuite"}}],"paging":{"total":3,"perPage":2,"offset":0}}`))
+		case "/services/data/lookup-table-files?output_mode=json&count=100&offset=2":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"identities.csv","acl":{"app":"SplunkEnterpriseSecuritySuite"}}],"paging":{"total":3,"perPage":2,"offset":2}}`))
+		case "/services/storage/collections/config?output_mode=json&count=100&offset=0":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"incident_review","acl":{"app":"SplunkEnterpriseSecuritySuite"}}],"paging":{"total":1,"perPage":100,"offset":0}}`))
+		case "/services/server/introspection/kvstore/collectionstats?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"collectionstats","content":{"data":["{\"ns\":\"SplunkEnterpriseSecuritySuite.incident_review\",\"count\":120,\"size\":52000}","{\"ns\":\"search.notes\",\"count\":3,\"size\":1024}"]}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkLookupCount.Enabled = true
+	metricsettings.Metrics.SplunkLookupSizeBytes.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeLookupCount(context.Background(), now, errs)
+	scraper.scrapeLookupSize(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.app.name")
+	require.Len(t, metrics["splunk.lookup.count"], 2)
+	require.Equal(t, int64(1), metrics["splunk.lookup.count"]["search"].Int())
+	require.Equal(t, int64(3), metrics["splunk.lookup.count"]["SplunkEnterpriseSecuritySuite"].Int())
+	require.Equal(t, int64(52000), metrics["splunk.lookup.size_bytes"]["SplunkEnterpriseSecuritySuite"].Int())
+	require.Equal(t, int64(1024), metrics["splunk.lookup.size_bytes"]["search"].Int())
+}
+
+func TestScrapeUsesServerTime(t *testing.T) {
+	// the Splunk server's clock is an hour ahead of the collector's
+	serverNow := time.Now().Add(time.Hour).UTC()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.String() {
+		case "/services/server/info?output_mode=json":
+			w.Header().Set("Date", serverNow.Format(http.TimeFormat))
+			w.WriteHeader(http.StatusOK)
+		case "/services/server/introspection/indexer?output_mode=json":
+			mockIndexerThroughput(w, r)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerThroughput.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.UseServerTime = true
+	cfg.ClockSkewTolerance = 5 * time.Second
+	scraper := createMockScraper(t, cfg)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	dp := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	require.WithinDuration(t, serverNow, dp.Timestamp().AsTime(), 2*time.Second)
+}
+
+func TestScrapeServerTimeUnavailable(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.String() {
+		case "/services/server/info?output_mode=json":
+			// a failed request still carries a Date header which must not be trusted
+			w.Header().Set("Date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/services/server/introspection/indexer?output_mode=json":
+			mockIndexerThroughput(w, r)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerThroughput.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.UseServerTime = true
+	cfg.ClockSkewTolerance = 5 * time.Second
+	scraper := createMockScraper(t, cfg)
+
+	// the metrics are still scraped successfully, timestamped with the local clock
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	dp := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	require.WithinDuration(t, time.Now(), dp.Timestamp().AsTime(), 2*time.Second)
+}
+
+func TestScrapeIngestionErrors(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>component</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>AggregatorMiningProcessor</text></value></field><field k="errors"><value><text>12</text></value></field></result><result offset="1"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>5</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionErrors(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.component")
+	require.Len(t, metrics["splunk.ingestion.errors"], 2)
+	require.Equal(t, int64(12), metrics["splunk.ingestion.errors"]["AggregatorMiningProcessor"].Int())
+	require.Equal(t, int64(5), metrics["splunk.ingestion.errors"]["LineBreakingProcessor"].Int())
+}
+
+func TestScrapeIndexBucketSizes(t *testing.T) {
+	// most buckets of main are small but a single oversized bucket skews the maximum far above the average
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>avg_size_bytes</field><field>max_size_bytes</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>main</text></value></field><field k="avg_size_bytes"><value><text>13107200</text></value></field><field k="max_size_bytes"><value><text>786432000</text></value></field></result><result offset="1"><field k="indexname"><value><text>web</text></value></field><field k="avg_size_bytes"><value><text>104857600</text></value></field><field k="max_size_bytes"><value><text>110100480</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexBucketAvgSizeBytes.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketMaxSizeBytes.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexBucketSizes(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(13107200), metrics["splunk.index.bucket.avg_size_bytes"]["main"].Int())
+	require.Equal(t, int64(786432000), metrics["splunk.index.bucket.max_size_bytes"]["main"].Int())
+	require.Equal(t, int64(104857600), metrics["splunk.index.bucket.avg_size_bytes"]["web"].Int())
+	require.Equal(t, int64(110100480), metrics["splunk.index.bucket.max_size_bytes"]["web"].Int())
+}
+
+func TestScrapeDeduplicateDataPoints(t *testing.T) {
+	// sh1 is reported twice by the search, which would otherwise produce two data points for the same host
+	results := `<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>latency_avg_exec</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>sh1</text></value></field><field k="latency_avg_exec"><value><text>5.0</text></value></field></result><result offset="1"><field k="host"><value><text>sh2</text></value></field><field k="latency_avg_exec"><value><text>1.0</text></value></field></result><result offset="2"><field k="host"><value><text>sh1</text></value></field><field k="latency_avg_exec"><value><text>3.0</text></value></field></result></results>`
+
+	tests := []struct {
+		mode     string
+		expected map[string]float64
+		points   int
+	}{
+		{mode: "", points: 3},
+		{mode: dedupLastWins, points: 2, expected: map[string]float64{"sh1": 3, "sh2": 1}},
+		{mode: dedupSum, points: 2, expected: map[string]float64{"sh1": 8, "sh2": 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode, func(t *testing.T) {
+			ts := createMockSearchServer(results)
+			defer ts.Close()
+
+			metricsettings := metadata.MetricsBuilderConfig{}
+			metricsettings.Metrics.SplunkSchedulerAvgExecutionLatency.Enabled = true
+
+			cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+			cfg.DeduplicateDataPoints = test.mode
+			scraper := createMockScraper(t, cfg)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+			require.Equal(t, test.points, dps.Len())
+			for i := 0; i < len(test.expected); i++ {
+				host, ok := dps.At(i).Attributes().Get("splunk.host")
+				require.True(t, ok)
+				require.Equal(t, test.expected[host.Str()], dps.At(i).DoubleValue())
+			}
+		})
+	}
+}
+
+func TestScrapeSchedulerDelegatedCount(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>delegated</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>sh1</text></value></field><field k="delegated"><value><text>42</text></value></field></result><result offset="1"><field k="host"><value><text>sh2</text></value></field><field k="delegated"><value><text>7</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSchedulerDelegatedCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSchedulerDelegatedCount(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Len(t, metrics["splunk.scheduler.delegated.count"], 2)
+	require.Equal(t, int64(42), metrics["splunk.scheduler.delegated.count"]["sh1"].Int())
+	require.Equal(t, int64(7), metrics["splunk.scheduler.delegated.count"]["sh2"].Int())
+}
+
+func TestScrapeClusterStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/cluster/master/info?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"master","content":{"indexing_ready_flag":true,"maintenance_mode":false,"rolling_restart_flag":false,"service_ready_flag":true}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterIndexingReady.Enabled = true
+	metricsettings.Metrics.SplunkClusterMaintenanceMode.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(1), metrics["splunk.cluster.indexing_ready"][""].Int())
+	require.Equal(t, int64(0), metrics["splunk.cluster.maintenance_mode"][""].Int())
+}
+
+func TestScrapeAttributeFilters(t *testing.T) {
+	// the same component reports errors on two hosts
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>component</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>12</text></value></field></result><result offset="1"><field k="host"><value><text>idx2</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>5</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.AttributeFilters = map[string]AttributeFilter{
+		"splunk.ingestion.errors": {Drop: []string{"splunk.host"}},
+	}
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// the per host values are aggregated into a single series for the component
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	require.Equal(t, int64(17), dps.At(0).IntValue())
+	require.Equal(t, map[string]any{"splunk.component": "LineBreakingProcessor"}, dps.At(0).Attributes().AsRaw())
+}
+
+func TestScrapeClusterFixups(t *testing.T) {
+	now := time.Now()
+	queued := func(ago time.Duration) int64 { return now.Add(-ago).Unix() }
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// one of the buckets has been waiting for a day while the others were queued recently
+		case "/services/cluster/master/fixup?output_mode=json&count=-1&level=search_factor":
+			_, _ = fmt.Fprintf(w, `{"entry":[{"name":"main~10~A","content":{"index":"main","initial":{"reason":"streaming failure","timestamp":%d},"latest":{"reason":"streaming failure","timestamp":%d}}},{"name":"main~11~A","content":{"index":"main","initial":{"reason":"added peer","timestamp":%d}}},{"name":"web~3~B","content":{"index":"web","initial":{"reason":"added peer","timestamp":%d}}}]}`,
+				queued(24*time.Hour), queued(time.Minute), queued(2*time.Minute), queued(time.Minute))
+		case "/services/cluster/master/fixup?output_mode=json&count=-1&level=replication_factor":
+			_, _ = w.Write([]byte(`{"entry":[]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterFixupPending.Enabled = true
+	metricsettings.Metrics.SplunkClusterFixupOldestAgeSeconds.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterFixups(context.Background(), pcommon.NewTimestampFromTime(now), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.cluster.fixup.level")
+	require.Equal(t, int64(3), metrics["splunk.cluster.fixup.pending"]["search_factor"].Int())
+	require.Equal(t, int64(24*60*60), metrics["splunk.cluster.fixup.oldest_age_seconds"]["search_factor"].Int())
+	require.Equal(t, int64(0), metrics["splunk.cluster.fixup.pending"]["replication_factor"].Int())
+	require.Equal(t, int64(0), metrics["splunk.cluster.fixup.oldest_age_seconds"]["replication_factor"].Int())
+}
+
+func TestScrapeSearchAttributeFields(t *testing.T) {
+	// the search was customized to report the host and component under different field names
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>splunk_server</field><field>processor</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="splunk_server"><value><text>idx1</text></value></field><field k="processor"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>5</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.SearchAttributeFields = map[string]map[string]string{
+		"SplunkIngestionErrors": {"host": "splunk_server", "component": "processor"},
+	}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionErrors(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	dps := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	require.Equal(t, int64(5), dps.At(0).IntValue())
+	require.Equal(t, map[string]any{"splunk.host": "idx1", "splunk.component": "LineBreakingProcessor"}, dps.At(0).Attributes().AsRaw())
+}
+
+func TestScrapeDatamodelBuilds(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// two of the three accelerated data models are being built at the same time
+		case "/services/admin/summarization?by_tstats=t&output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"tstats:DM_Splunk_SA_CIM_Authentication","content":{"summary.is_inprogress":true}},{"name":"tstats:DM_Splunk_SA_CIM_Network_Traffic","content":{"summary.is_inprogress":"1"}},{"name":"tstats:DM_Splunk_SA_CIM_Web","content":{"summary.is_inprogress":false}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDatamodelBuildsRunning.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeDatamodelBuilds(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(2), metrics["splunk.datamodel.builds.running"][""].Int())
+}
+
+func TestScrapeStableStartTime(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>component</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>5</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	// the receiver is started some time after it was created
+	time.Sleep(10 * time.Millisecond)
+	before := time.Now()
+	require.NoError(t, scraper.start(context.Background(), host))
+
+	startTimes := make([]pcommon.Timestamp, 0, 2)
+	for i := 0; i < 2; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+		require.Greater(t, dp.Timestamp(), dp.StartTimestamp())
+		startTimes = append(startTimes, dp.StartTimestamp())
+	}
+
+	require.Equal(t, startTimes[0], startTimes[1])
+	require.False(t, startTimes[0].AsTime().Before(before))
+}
+
+func TestScrapeEventsIndexed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"totalEventCount":1500}},{"name":"web","content":{"totalEventCount":42}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerEventsIndexed.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexesEventCount(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	m := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "splunk.indexer.events_indexed", m.Name())
+	require.Equal(t, pmetric.MetricTypeSum, m.Type())
+	require.True(t, m.Sum().IsMonotonic())
+	require.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+
+	dps := m.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		index, _ := dps.At(i).Attributes().Get("splunk.index.name")
+		require.Equal(t, map[string]int64{"main": 1500, "web": 42}[index.Str()], dps.At(i).IntValue())
+	}
+}
+
+func TestScrapeSearchTimeout(t *testing.T) {
+	// the search job is dispatched but its results never become available
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchTimeout.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.ScraperControllerSettings.Timeout = 100 * time.Millisecond
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errMaxSearchWaitTimeExceeded)
+	require.ErrorContains(t, err, "SplunkIngestionErrors")
+
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "splunk.receiver.search.timeout", m.Name())
+	require.Equal(t, 1, m.Sum().DataPoints().Len())
+	name, _ := m.Sum().DataPoints().At(0).Attributes().Get("search_name")
+	require.Equal(t, "SplunkIngestionErrors", name.Str())
+	require.Equal(t, int64(1), m.Sum().DataPoints().At(0).IntValue())
+}
+
+func TestScrapeIndexSearchableTest(t *testing.T) {
+	// main returns an event while the search against empty finds nothing
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			sid := "main"
+			if strings.Contains(string(body), "index=empty") {
+				sid = "empty"
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><response><sid>%s</sid></response>`, sid)
+			return
+		}
+
+		events := "1"
+		if r.URL.Path == "/services/search/jobs/empty/results" {
+			events = "0"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>events</field></fieldOrder></meta><result offset="0"><field k="events"><value><text>%s</text></value></field></result></results>`, events)
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexSearchableTest.Enabled = true
+
+	cfg := createMockConfig(typeSh, ts.URL, metricsettings)
+	cfg.SearchableTestIndexes = []string{"main", "empty"}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexSearchableTest(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(1), metrics["splunk.index.searchable_test"]["main"].Int())
+	require.Equal(t, int64(0), metrics["splunk.index.searchable_test"]["empty"].Int())
+}
+
+func TestWarmupSearch(t *testing.T) {
+	dispatched := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/services/search/jobs/" {
+			body, _ := io.ReadAll(r.Body)
+			form, _ := url.ParseQuery(string(body))
+			dispatched <- form.Get("search")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		http.NotFoundHandler().ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	cfg := createMockConfig(typeCm, ts.URL, metadata.MetricsBuilderConfig{})
+	cfg.WarmupSearch = "search index=_internal earliest=-10m | head 1"
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+
+	require.NoError(t, scraper.start(context.Background(), host))
+
+	select {
+	case search := <-dispatched:
+		require.Equal(t, cfg.WarmupSearch, search)
+	default:
+		t.Fatal("the warmup search was not dispatched during start")
+	}
+}
+
+func TestScrapeDMCHealth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// idx2 is up but unhealthy while idx3 is down altogether
+		case "/services/search/distributed/peers?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"idx1:8089","content":{"peerName":"idx1","status":"Up","health_status":"Healthy","server_roles":["indexer","license_slave"]}},` +
+				`{"name":"idx2:8089","content":{"peerName":"idx2","status":"Up","health_status":"Unhealthy","server_roles":["indexer"]}},` +
+				`{"name":"idx3:8089","content":{"peerName":"idx3","status":"Down","health_status":"","server_roles":["indexer"]}},` +
+				`{"name":"sh1:8089","content":{"peerName":"sh1","status":"Up","health_status":"Healthy","server_roles":["search_head"]}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDmcInstancesUnhealthy.Enabled = true
+	metricsettings.Metrics.SplunkDmcInstances.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeDMCHealth(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	instances := map[string]int64{}
+	var unhealthy int64
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			if ms.At(i).Name() == "splunk.dmc.instances.unhealthy" {
+				unhealthy = dps.At(j).IntValue()
+				continue
+			}
+			role, _ := dps.At(j).Attributes().Get("splunk.server.role")
+			status, _ := dps.At(j).Attributes().Get("splunk.health.status")
+			instances[role.Str()+"/"+status.Str()] = dps.At(j).IntValue()
+		}
+	}
+
+	require.Equal(t, int64(2), unhealthy)
+	require.Equal(t, map[string]int64{
+		"indexer/healthy":       1,
+		"indexer/unhealthy":     2,
+		"license_slave/healthy": 1,
+		"search_head/healthy":   1,
+	}, instances)
+}
+
+func TestMaxScrapeDuration(t *testing.T) {
+	var ingestionErrorsDispatched atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			// the license usage search is scraped first and is slow to dispatch
+			if strings.Contains(string(body), "license_usage.log") {
+				time.Sleep(200 * time.Millisecond)
+			}
+			if strings.Contains(string(body), "component=*Processor") {
+				ingestionErrorsDispatched.Store(true)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>main</text></value></field><field k="By"><value><text>1024</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkLicenseIndexUsage.Enabled = true
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.MaxScrapeDuration = 100 * time.Millisecond
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, errMaxScrapeDurationExceeded.Error())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.False(t, ingestionErrorsDispatched.Load())
+
+	// the license usage gathered before the limit was hit is still reported
+	require.Equal(t, 1, md.MetricCount())
+	require.Equal(t, "splunk.license.index.usage", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestScrapeBundlePushSize(t *testing.T) {
+	// idx2 was pushed a far larger bundle than idx1
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>peer</field><field>size_bytes</field></fieldOrder></meta><result offset="0"><field k="peer"><value><text>idx1</text></value></field><field k="size_bytes"><value><text>52428800</text></value></field></result><result offset="1"><field k="peer"><value><text>idx2</text></value></field><field k="size_bytes"><value><text>838860800</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkBundlePushSizeBytes.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeBundlePushSize(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.peer")
+	require.Equal(t, int64(52428800), metrics["splunk.bundle.push.size_bytes"]["idx1"].Int())
+	require.Equal(t, int64(838860800), metrics["splunk.bundle.push.size_bytes"]["idx2"].Int())
+}
+
+func TestScrapeIndexBucketUtilization(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// main holds 285 of its 300 warm buckets while web has plenty of room left
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"maxWarmDBCount":300,"bucket_dirs":{"home":{"warm_bucket_count":"285"}}}},{"name":"web","content":{"maxWarmDBCount":300,"bucket_dirs":{"home":{"warm_bucket_count":"30"}}}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexMaxBuckets.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketUtilizationRatio.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexBucketUtilization(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(300), metrics["splunk.index.max_buckets"]["main"].Int())
+	require.InDelta(t, 0.95, metrics["splunk.index.bucket_utilization_ratio"]["main"].Double(), 0.001)
+	require.InDelta(t, 0.1, metrics["splunk.index.bucket_utilization_ratio"]["web"].Double(), 0.001)
+}
+
+func TestScrapeSchedulerContinuedCount(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>savedsearch_name</field><field>continued</field></fieldOrder></meta><result offset="0"><field k="savedsearch_name"><value><text>Hourly Threat Summary</text></value></field><field k="continued"><value><text>3</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSchedulerContinuedCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSchedulerContinuedCount(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.savedsearch")
+	require.Len(t, metrics["splunk.scheduler.continued.count"], 1)
+	require.Equal(t, int64(3), metrics["splunk.scheduler.continued.count"]["Hourly Threat Summary"].Int())
+}
+
+func TestScrapeIndexRetentionUtilization(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>title</field><field>median_data_age</field><field>frozen_time_period_secs</field></fieldOrder></meta>` +
+		`<result offset="0"><field k="title"><value><text>main</text></value></field><field k="median_data_age"><value><text>81</text></value></field><field k="frozen_time_period_secs"><value><text>7776000</text></value></field></result>` +
+		`<result offset="1"><field k="title"><value><text>summary</text></value></field><field k="median_data_age"><value><text>12</text></value></field><field k="frozen_time_period_secs"><value><text>0</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexRetentionUtilizationRatio.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexesBucketCountAdHoc(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.index.retention_utilization_ratio"], 1)
+	require.InDelta(t, 0.9, metrics["splunk.index.retention_utilization_ratio"]["main"].Double(), 1e-9)
+}
+
+func TestScrapeSearchRows(t *testing.T) {
+	row := func(offset int, name string, count string) string {
+		return fmt.Sprintf(`<result offset="%d"><field k="savedsearch_name"><value><text>%s</text></value></field><field k="continued"><value><text>%s</text></value></field></result>`, offset, name, count)
+	}
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>savedsearch_name</field><field>continued</field></fieldOrder></meta>` +
+		row(0, "Hourly Threat Summary", "3") + row(1, "Daily License Report", "1") + row(2, "Errors By Host", "2") + `</results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSchedulerContinuedCount.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchRows.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSchedulerContinuedCount(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found bool
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "splunk.receiver.search.rows" {
+			continue
+		}
+		found = true
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		require.Equal(t, int64(3), dp.IntValue())
+		name, ok := dp.Attributes().Get("search_name")
+		require.True(t, ok)
+		require.Equal(t, "SplunkSchedulerContinuedCount", name.Str())
+	}
+	require.True(t, found)
+}
+
+func TestScrapeClusterGeneration(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/cluster/master/generation/master?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"master","content":{"generation_id":"42","pending_generation_id":"43"}}]}`))
+		case "/services/cluster/master/peers?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"1F4C1CE1-2D3B-4E5F-8A9B-0C1D2E3F4A5B","content":{"label":"idx1","base_generation_id":42}},` +
+				`{"name":"6B7C8D9E-0F1A-2B3C-4D5E-6F7A8B9C0D1E","content":{"label":"idx2","base_generation_id":39}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterGenerationID.Enabled = true
+	metricsettings.Metrics.SplunkClusterPeerGenerationLag.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterGeneration(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	lags := map[string]int64{}
+	for i := 0; i < ms.Len(); i++ {
+		dps := ms.At(i).Gauge().DataPoints()
+		switch ms.At(i).Name() {
+		case "splunk.cluster.generation_id":
+			require.Equal(t, int64(42), dps.At(0).IntValue())
+		case "splunk.cluster.peer.generation_lag":
+			for j := 0; j < dps.Len(); j++ {
+				peer, ok := dps.At(j).Attributes().Get("splunk.peer")
+				require.True(t, ok)
+				lags[peer.Str()] = dps.At(j).IntValue()
+			}
+		}
+	}
+	require.Equal(t, map[string]int64{"idx1": 0, "idx2": 3}, lags)
+}
+
+func TestIndexDiscovery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/services/search/jobs/":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+		case r.URL.Path == "/services/search/jobs/123/results":
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>index</field></fieldOrder></meta>` +
+				`<result offset="0"><field k="index"><value><text>main</text></value></field></result>` +
+				`<result offset="1"><field k="index"><value><text>web</text></value></field></result>` +
+				`<result offset="2"><field k="index"><value><text>security</text></value></field></result></results>`))
+		case r.URL.Path == "/services/data/indexes-extended":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"_audit","content":{"total_size":"10"}},` +
+				`{"name":"main","content":{"total_size":"20"}},` +
+				`{"name":"web","content":{"total_size":"30"}},` +
+				`{"name":"sandbox","content":{"total_size":"40"}},` +
+				`{"name":"security","content":{"total_size":"50"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.IndexDiscoverySearch = "| rest /services/data/indexes | search title!=_* | rename title as index | fields index"
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	var indexes []string
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		index, ok := dps.At(i).Attributes().Get("splunk.index.name")
+		require.True(t, ok)
+		indexes = append(indexes, index.Str())
+	}
+	require.ElementsMatch(t, []string{"main", "web", "security"}, indexes)
+}
+
+func TestScrapeInternalIndexes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/data/indexes-extended":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"_internal","content":{"total_size":"10"}},` +
+				`{"name":"_introspection","content":{"total_size":"20"}},` +
+				`{"name":"main","content":{"total_size":"30"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		desc     string
+		include  bool
+		expected []string
+	}{
+		{desc: "excluded by default", expected: []string{"main"}},
+		{desc: "included when enabled", include: true, expected: []string{"_internal", "_introspection", "main"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			metricsettings := metadata.MetricsBuilderConfig{}
+			metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+
+			cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+			cfg.IncludeInternalIndexes = test.include
+			scraper := createMockScraper(t, cfg)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			var indexes []string
+			dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+			for i := 0; i < dps.Len(); i++ {
+				index, ok := dps.At(i).Attributes().Get("splunk.index.name")
+				require.True(t, ok)
+				indexes = append(indexes, index.Str())
+			}
+			require.ElementsMatch(t, test.expected, indexes)
+		})
+	}
+}
+
+func TestScrapeKVStoreStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// the oplog only covers ten minutes of operations
+		case "/services/kvstore/status?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"status","content":{"current":{"status":"ready","replicationStatus":"KV store captain","oplogStartTimestamp":"1700000000","oplogEndTimestamp":1700000600,"storageSize":73400320}}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkKvstoreOplogWindowSeconds.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreDiskUsedBytes.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeKVStoreStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(600), metrics["splunk.kvstore.oplog.window_seconds"][""].Int())
+	require.Equal(t, int64(73400320), metrics["splunk.kvstore.disk_used_bytes"][""].Int())
+}
+
+func TestScrapeKVStoreMembers(t *testing.T) {
+	// sh3 is still recovering and does not replicate yet
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/kvstore/status?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"status","content":{"current":{"status":"ready","hostAndPort":"sh1:8191","replicationStatus":"KV store captain"},"members":{` +
+				`"0":{"hostAndPort":"sh1:8191","replicationStatus":"KV store captain"},` +
+				`"1":{"hostAndPort":"sh2:8191","replicationStatus":"Non-captain KV store member"},` +
+				`"2":{"hostAndPort":"sh3:8191","replicationStatus":"Recovering"}}}}]}`))
+		case "/services/server/introspection/kvstore/collectionstats?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"collections","content":{"data":[` +
+				`"{\"ns\":\"search.assets\",\"count\":12,\"size\":4096}",` +
+				`"{\"ns\":\"SA-ThreatIntelligence.threat_ip\",\"count\":500,\"size\":\"1048576\"}"]}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkKvstoreMemberUp.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreCollectionSize.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeKVStoreStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.kvstore.member")
+	require.Equal(t, map[string]pcommon.Value{
+		"sh1:8191": pcommon.NewValueInt(1),
+		"sh2:8191": pcommon.NewValueInt(1),
+		"sh3:8191": pcommon.NewValueInt(0),
+	}, metrics["splunk.kvstore.member.up"])
+
+	scraper.scrapeKVStoreCollections(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics = emittedGauges(t, &scraper, "splunk.kvstore.collection")
+	require.Equal(t, map[string]pcommon.Value{
+		"assets":    pcommon.NewValueInt(4096),
+		"threat_ip": pcommon.NewValueInt(1048576),
+	}, metrics["splunk.kvstore.collection.size"])
+}
+
+func TestScrapeSilentIndexes(t *testing.T) {
+	now := time.Now()
+	maxTime := func(ago time.Duration) string {
+		return now.Add(-ago).Format(time.RFC3339)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"entry":[`+
+				`{"name":"main","content":{"maxTime":%q}},`+
+				`{"name":"web","content":{"maxTime":%q}},`+
+				`{"name":"legacy","content":{"maxTime":%q}},`+
+				`{"name":"firewall","content":{"maxTime":%q}},`+
+				`{"name":"sandbox","content":{}}]}`,
+				maxTime(time.Minute), maxTime(5*time.Hour), maxTime(72*time.Hour), maxTime(7*time.Hour))))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexesSilentCount.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.SilentIndexThreshold = 6 * time.Hour
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSilentIndexes(context.Background(), pcommon.NewTimestampFromTime(now), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(2), metrics["splunk.indexes.silent.count"][""].Int())
+}
+
+func TestScrapeACSIndexes(t *testing.T) {
+	// a fake Admin Config Service serving a stack with more indexes than fit on a page
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-stack/adminconfig/v2/indexes" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		pages = append(pages, r.URL.Query().Get("offset"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("offset") == "0" {
+			indexes := make([]string, 100)
+			for i := range indexes {
+				indexes[i] = fmt.Sprintf(`{"name":"app_%d","datatype":"event","searchableDays":90,"totalEventCount":"1","totalRawSizeMB":"1"}`, i)
+			}
+			_, _ = w.Write([]byte("[" + strings.Join(indexes, ",") + "]"))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name":"main","datatype":"event","searchableDays":90,"maxDataSizeMB":0,"totalEventCount":"1532","totalRawSizeMB":"3"}]`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedEventCount.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedRawSize.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeACS, ts.URL+"/my-stack", metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeACSIndexes(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, []string{"0", "100"}, pages)
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.data.indexes.extended.event.count"], 101)
+	require.Equal(t, int64(1532), metrics["splunk.data.indexes.extended.event.count"]["main"].Int())
+	require.Equal(t, int64(3*1024*1024), metrics["splunk.data.indexes.extended.raw.size"]["main"].Int())
+}
+
+func TestScrapeQueueThroughput(t *testing.T) {
+	row := func(offset int, host, queue, eps string) string {
+		return fmt.Sprintf(`<result offset="%d"><field k="host"><value><text>%s</text></value></field><field k="queue"><value><text>%s</text></value></field><field k="events_per_second"><value><text>%s</text></value></field></result>`, offset, host, queue, eps)
+	}
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>queue</field><field>events_per_second</field></fieldOrder></meta>` +
+		row(0, "idx1", "parsingqueue", "1520.5") + row(1, "idx1", "indexqueue", "1498.25") + `</results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkQueueEventsPerSecond.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeQueueThroughput(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.queue.name")
+	require.Len(t, metrics["splunk.queue.events_per_second"], 2)
+	require.InDelta(t, 1520.5, metrics["splunk.queue.events_per_second"]["parsingqueue"].Double(), 1e-9)
+	require.InDelta(t, 1498.25, metrics["splunk.queue.events_per_second"]["indexqueue"].Double(), 1e-9)
+}
+
+func TestInitialResultDelay(t *testing.T) {
+	var dispatched, firstPoll atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			dispatched.Store(time.Now().UnixNano())
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		firstPoll.CompareAndSwap(0, time.Now().UnixNano())
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>component</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>12</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.InitialResultDelays = map[string]time.Duration{"SplunkIngestionErrors": 300 * time.Millisecond}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionErrors(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	require.NotZero(t, firstPoll.Load())
+	require.GreaterOrEqual(t, time.Duration(firstPoll.Load()-dispatched.Load()), 300*time.Millisecond)
+}
+
+func TestScrapeIndexerThrottledSeconds(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>throttled_seconds</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx2</text></value></field><field k="throttled_seconds"><value><text>150</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerThrottledSeconds.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerThrottledSeconds(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Len(t, metrics["splunk.indexer.throttled_seconds"], 1)
+	require.Equal(t, int64(150), metrics["splunk.indexer.throttled_seconds"]["idx2"].Int())
+}
+
+func TestScrapeResourcePerHost(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>component</field><field>errors</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>12</text></value></field></result><result offset="1"><field k="host"><value><text>idx2</text></value></field><field k="component"><value><text>LineBreakingProcessor</text></value></field><field k="errors"><value><text>5</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionErrors.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.ResourcePerHost = true
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	counts := map[string]int64{}
+	rms := md.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+	for i := 0; i < rms.Len(); i++ {
+		host, ok := rms.At(i).Resource().Attributes().Get("host.name")
+		require.True(t, ok)
+		dps := rms.At(i).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+		require.Equal(t, 1, dps.Len())
+		_, ok = dps.At(0).Attributes().Get("splunk.host")
+		require.False(t, ok)
+		counts[host.Str()] = dps.At(0).IntValue()
+	}
+	require.Equal(t, map[string]int64{"idx1": 12, "idx2": 5}, counts)
+}
+
+func TestScrapeBlockedQueuesHealth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/server/health/splunkd/details?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"details","content":{"health":"yellow","features":{` +
+				`"File Monitor Input":{"health":"green","features":{}},` +
+				`"Index Processor":{"health":"yellow","features":{"Buckets":{"health":"green"},"Blocked Queues":{"health":"yellow","num_red":0,"num_yellow":1}}}}}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkHealthBlockedQueues.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeBlockedQueuesHealth(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(1), metrics["splunk.health.blocked_queues"][""].Int())
+}
+
+func TestCaptainOnly(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		serverName string
+		lookups    int
+	}{
+		{name: "captain", serverName: "sh1", lookups: 1},
+		{name: "member", serverName: "sh2", lookups: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.String() {
+				case "/services/server/info?output_mode=json":
+					_, _ = w.Write([]byte(`{"entry":[{"name":"server-info","content":{"serverName":"` + tc.serverName + `"}}]}`))
+				case "/services/shcluster/status?output_mode=json":
+					_, _ = w.Write([]byte(`{"entry":[{"name":"status","content":{"captain":{"label":"sh1","mgmt_uri":"https://sh1:8089"}}}]}`))
+				case "/services/data/lookup-table-files?output_mode=json&count=100&offset=0":
+					_, _ = w.Write([]byte(`{"entry":[{"name":"geo.csv","acl":{"app":"search"}}],"paging":{"total":1,"perPage":100,"offset":0}}`))
+				case "/services/storage/collections/config?output_mode=json&count=100&offset=0":
+					_, _ = w.Write([]byte(`{"entry":[],"paging":{"total":0,"perPage":100,"offset":0}}`))
+				default:
+					http.NotFoundHandler().ServeHTTP(w, r)
+				}
+			}))
+			defer ts.Close()
+
+			metricsettings := metadata.MetricsBuilderConfig{}
+			metricsettings.Metrics.SplunkLookupCount.Enabled = true
+
+			cfg := createMockConfig(typeSh, ts.URL, metricsettings)
+			cfg.CaptainOnly = true
+			scraper := createMockScraper(t, cfg)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.lookups, md.DataPointCount())
+		})
+	}
+}
+
+func TestScrapeIndexFrozenArchive(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"coldToFrozenDir":"","coldToFrozenScript":""}},` +
+				`{"name":"firewall","content":{"coldToFrozenDir":"/archive/firewall","coldToFrozenScript":""}},` +
+				`{"name":"audit","content":{"coldToFrozenDir":"","coldToFrozenScript":"$SPLUNK_HOME/bin/coldToFrozenExample.py"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexFrozenArchiveConfigured.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexConfig(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.index.frozen_archive_configured"], 3)
+	require.Equal(t, int64(0), metrics["splunk.index.frozen_archive_configured"]["main"].Int())
+	require.Equal(t, int64(1), metrics["splunk.index.frozen_archive_configured"]["firewall"].Int())
+	require.Equal(t, int64(1), metrics["splunk.index.frozen_archive_configured"]["audit"].Int())
+}
+
+func TestScrapeRealtimeAlerts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/saved/searches?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"Brute Force","content":{"disabled":false,"is_scheduled":true,"actions":"email","alert.track":true,"alert.digest_mode":false,"dispatch.earliest_time":"rt-5m"}},` +
+				`{"name":"Realtime Report","content":{"disabled":false,"is_scheduled":true,"actions":"","alert.track":false,"alert.digest_mode":true,"dispatch.earliest_time":"rt-1h"}},` +
+				`{"name":"Daily Errors","content":{"disabled":false,"is_scheduled":true,"actions":"email","alert.track":"1","alert.digest_mode":"1","dispatch.earliest_time":"-24h"}},` +
+				`{"name":"Old Alert","content":{"disabled":true,"is_scheduled":true,"actions":"email","alert.track":true,"alert.digest_mode":true,"dispatch.earliest_time":"rt"}}]}`))
+		case "/services/alerts/fired_alerts?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"-","content":{"triggered_alert_count":4}},` +
+				`{"name":"Brute Force","content":{"triggered_alert_count":3}},` +
+				`{"name":"Daily Errors","content":{"triggered_alert_count":"1"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkAlertsRealtimeCount.Enabled = true
+	metricsettings.Metrics.SplunkAlertsFiring.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeRealtimeAlerts(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.alert.mode")
+	require.Equal(t, int64(1), metrics["splunk.alerts.realtime.count"]["per_result"].Int())
+	require.Equal(t, int64(0), metrics["splunk.alerts.realtime.count"]["digest"].Int())
+
+	scraper.scrapeFiredAlerts(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics = emittedGauges(t, &scraper, "splunk.savedsearch")
+	require.Len(t, metrics["splunk.alerts.firing"], 2)
+	require.Equal(t, int64(3), metrics["splunk.alerts.firing"]["Brute Force"].Int())
+	require.Equal(t, int64(1), metrics["splunk.alerts.firing"]["Daily Errors"].Int())
+}
+
+func TestScrapeGroup(t *testing.T) {
+	var lookupRequests atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/alerts/fired_alerts?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"Brute Force","content":{"triggered_alert_count":3}}]}`))
+		case "/services/data/lookup-table-files?output_mode=json&count=100&offset=0":
+			lookupRequests.Add(1)
+			_, _ = w.Write([]byte(`{"entry":[],"paging":{"total":0,"perPage":100,"offset":0}}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkAlertsFiring.Enabled = true
+	metricsettings.Metrics.SplunkLookupCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	md, err := scraper.scrapeGroup(context.Background(), "alerts")
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+	require.Equal(t, "splunk.alerts.firing", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+	require.Zero(t, lookupRequests.Load())
+
+	_, err = scraper.scrapeGroup(context.Background(), "nonexistent")
+	require.ErrorIs(t, err, errUnknownScrapeGroup)
+}
+
+func TestScrapeIndexerSearchesServed(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>searches_served</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="searches_served"><value><text>412</text></value></field></result><result offset="1"><field k="host"><value><text>idx2</text></value></field><field k="searches_served"><value><text>37</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerSearchesServed.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerSearchesServed(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Len(t, metrics["splunk.indexer.searches_served"], 2)
+	require.Equal(t, int64(412), metrics["splunk.indexer.searches_served"]["idx1"].Int())
+	require.Equal(t, int64(37), metrics["splunk.indexer.searches_served"]["idx2"].Int())
+}
+
+func TestScrapeStateFields(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>is_searchable</field><field>status</field><field>bucket_count</field></fieldOrder></meta>` +
+		`<result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="is_searchable"><value><text>1</text></value></field><field k="status"><value><text>Complete</text></value></field><field k="bucket_count"><value><text>10</text></value></field></result>` +
+		`<result offset="1"><field k="host"><value><text>idx1</text></value></field><field k="is_searchable"><value><text>0</text></value></field><field k="status"><value><text>PendingDiscard</text></value></field><field k="bucket_count"><value><text>2</text></value></field></result>` +
+		`<result offset="2"><field k="host"><value><text>idx2</text></value></field><field k="is_searchable"><value><text>1</text></value></field><field k="status"><value><text>Complete</text></value></field><field k="bucket_count"><value><text>7</text></value></field></result>` +
+		`<result offset="3"><field k="host"><value><text>idx2</text></value></field><field k="is_searchable"><value><text>0</text></value></field><field k="status"><value><text>Unknown</text></value></field><field k="bucket_count"><value><text>1</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkBucketsSearchableStatus.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchFieldState.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.StateFields = map[string]map[string]map[string]int64{
+		"SplunkBucketsSearchableStatus": {"status": {"Complete": 0, "PendingDiscard": 1}},
+	}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeBucketsSearchableStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	states := map[string]int64{}
+	ms := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "splunk.receiver.search.field.state" {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			field, _ := dps.At(j).Attributes().Get("splunk.search.field")
+			require.Equal(t, "status", field.Str())
+			value, _ := dps.At(j).Attributes().Get("splunk.search.field.value")
+			states[value.Str()] = dps.At(j).IntValue()
+		}
+	}
+	// every distinct mapped value is reported once and unmapped values are left out
+	require.Equal(t, map[string]int64{"Complete": 0, "PendingDiscard": 1}, states)
+}
+
+func TestScrapeSchedulerSkippedTotal(t *testing.T) {
+	rows := map[string]int64{"sh1": 3, "sh2": 4, "sh3": 0}
+	results := `<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>completion_ratio</field><field>skipped_exec</field></fieldOrder></meta>`
+	var sum int64
+	i := 0
+	for host, skipped := range rows {
+		results += fmt.Sprintf(`<result offset="%d"><field k="host"><value><text>%s</text></value></field><field k="completion_ratio"><value><text>90</text></value></field><field k="skipped_exec"><value><text>%d</text></value></field></result>`, i, host, skipped)
+		sum += skipped
+		i++
+	}
+	ts := createMockSearchServer(results + `</results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSchedulerSkippedTotal.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSchedulerCompletionRatioByHost(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, sum, metrics["splunk.scheduler.skipped.total"][""].Int())
+}
+
+func TestScrapeIndexFrozenArchiveFailures(t *testing.T) {
+	var search atomic.Value
+	ts := createRecordingSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>archive_failures</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>firewall</text></value></field><field k="archive_failures"><value><text>6</text></value></field></result></results>`, &search)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexFrozenArchiveFailures.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexFrozenArchiveFailures(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	// the index name is one or more characters up to a space or comma
+	require.Contains(t, search.Load(), `rex "idx=(?<indexname>[^ ,]+)"`)
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.index.frozen.archive_failures"], 1)
+	require.Equal(t, int64(6), metrics["splunk.index.frozen.archive_failures"]["firewall"].Int())
+}
+
+func TestScrapeServerUptime(t *testing.T) {
+	startup := time.Now().Add(-90 * time.Minute).Unix()
+	var infoRequests atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.String() {
+		case "/services/server/info?output_mode=json":
+			infoRequests.Add(1)
+			w.Header().Set("Date", time.Now().UTC().Format(http.TimeFormat))
+			_, _ = fmt.Fprintf(w, `{"entry":[{"name":"server-info","content":{"serverName":"idx1","startup_time":%d}}]}`, startup)
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkServerUptimeSeconds.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.UseServerTime = true
+	cfg.ClockSkewTolerance = 5 * time.Second
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	host, _ := dp.Attributes().Get("splunk.host")
+	require.Equal(t, "idx1", host.Str())
+	require.InDelta(t, 90*60, dp.IntValue(), 2)
+	// the server time and the uptime are read from the same response
+	require.Equal(t, int64(1), infoRequests.Load())
+}
+
+func TestScrapeIndexSharedGlobally(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","acl":{"app":"system","sharing":"system"},"content":{}},` +
+				`{"name":"hr_payroll","acl":{"app":"hr","sharing":"global"},"content":{}},` +
+				`{"name":"web","acl":{"app":"search","sharing":"app"},"content":{}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexSharedGlobally.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexConfig(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.index.shared_globally"], 3)
+	require.Equal(t, int64(0), metrics["splunk.index.shared_globally"]["main"].Int())
+	require.Equal(t, int64(1), metrics["splunk.index.shared_globally"]["hr_payroll"].Int())
+	require.Equal(t, int64(0), metrics["splunk.index.shared_globally"]["web"].Int())
+}
+
+func TestScrapeUseResultTime(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>_time</field><field>host</field><field>throttled_seconds</field></fieldOrder></meta>` +
+		`<result offset="0"><field k="_time"><value><text>2024-03-07T10:15:00.000+00:00</text></value></field><field k="host"><value><text>idx1</text></value></field><field k="throttled_seconds"><value><text>30</text></value></field></result>` +
+		`<result offset="1"><field k="_time"><value><text>1709806800</text></value></field><field k="host"><value><text>idx2</text></value></field><field k="throttled_seconds"><value><text>60</text></value></field></result>` +
+		`<result offset="2"><field k="_time"><value><text>not a time</text></value></field><field k="host"><value><text>idx3</text></value></field><field k="throttled_seconds"><value><text>90</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerThrottledSeconds.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.UseResultTime = true
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeIndexerThrottledSeconds(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	stamps := map[string]pcommon.Timestamp{}
+	dps := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		host, _ := dps.At(i).Attributes().Get("splunk.host")
+		stamps[host.Str()] = dps.At(i).Timestamp()
+	}
+	require.Equal(t, time.Date(2024, 3, 7, 10, 15, 0, 0, time.UTC), stamps["idx1"].AsTime().UTC())
+	require.Equal(t, time.Unix(1709806800, 0).UTC(), stamps["idx2"].AsTime().UTC())
+	// rows whose _time cannot be parsed keep the scrape time
+	require.Equal(t, now, stamps["idx3"])
+}
+
+func TestScrapeIndexerEventsDroppedNoIndex(t *testing.T) {
+	var search atomic.Value
+	ts := createRecordingSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>events_dropped</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>fw_logs</text></value></field><field k="events_dropped"><value><text>1250</text></value></field></result></results>`, &search)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerEventsDroppedNoIndex.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+	// the missing index is never discovered, yet its drops are still reported
+	scraper.discoveredIndexes = map[string]bool{"main": true}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.DataPointCount())
+
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	index, _ := dp.Attributes().Get("splunk.index.name")
+	require.Equal(t, "fw_logs", index.Str())
+	require.Equal(t, int64(1250), dp.IntValue())
+	// the index name is one or more characters up to the space before "with"
+	require.Contains(t, search.Load(), `rex "index=(?<indexname>[^ ]+) with"`)
+}
+
+func TestScrapeClusterBucketsReplicating(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		// the buckets are split over two pages
+		case "/services/cluster/master/buckets?output_mode=json&count=1000&offset=0":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main~1~A","content":{"peers":{"A":{"status":"StreamingSource"},"B":{"status":"StreamingTarget"}}}},` +
+				`{"name":"main~2~A","content":{"peers":{"A":{"status":"Complete"},"B":{"status":"Complete"}}}}],` +
+				`"paging":{"total":3,"perPage":2,"offset":0}}`))
+		case "/services/cluster/master/buckets?output_mode=json&count=1000&offset=2":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"web~7~B","content":{"peers":{"A":{"status":"NonStreamingTarget"},"B":{"status":"Complete"},"C":{"status":"NonStreamingTarget"}}}}],` +
+				`"paging":{"total":3,"perPage":2,"offset":2}}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterBucketsReplicating.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterBucketsReplicating(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(2), metrics["splunk.cluster.buckets.replicating"][""].Int())
+}
+
+func TestScrapeIndexBucketsQuarantined(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>quarantined</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>web</text></value></field><field k="quarantined"><value><text>2</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterIndexBucketsQuarantined.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexBucketsQuarantined(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Len(t, metrics["splunk.cluster.index.buckets.quarantined"], 1)
+	require.Equal(t, int64(2), metrics["splunk.cluster.index.buckets.quarantined"]["web"].Int())
+}
+
+func TestIndexConfigRefresh(t *testing.T) {
+	var configRequests, listingRequests atomic.Int64
+	var created atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		indexes := `{"name":"main","content":{}}`
+		if created.Load() {
+			indexes += `,{"name":"web","content":{}}`
+		}
+		switch r.URL.String() {
+		case "/services/data/indexes?output_mode=json&count=-1":
+			configRequests.Add(1)
+			_, _ = w.Write([]byte(`{"entry":[` + indexes + `]}`))
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			listingRequests.Add(1)
+			_, _ = w.Write([]byte(`{"entry":[` + indexes + `]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexFrozenArchiveConfigured.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.IndexConfigRefreshInterval = time.Hour
+	scraper := createMockScraper(t, cfg)
+
+	// the full configuration is only fetched on the first scrape of the refresh window
+	for i := 0; i < 3; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, md.DataPointCount())
+	}
+	require.Equal(t, int64(1), configRequests.Load())
+	require.Equal(t, int64(2), listingRequests.Load())
+
+	// a new index triggers an early refresh
+	created.Store(true)
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.DataPointCount())
+	require.Equal(t, int64(2), configRequests.Load())
+
+	// once the window has passed the full configuration is fetched again
+	scraper.indexConfig.fetchedAt = time.Now().Add(-2 * time.Hour)
+	_, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), configRequests.Load())
+}
+
+func TestScrapeIngestionTruncations(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>sourcetype</field><field>truncations</field></fieldOrder></meta><result offset="0"><field k="sourcetype"><value><text>app:json</text></value></field><field k="truncations"><value><text>37</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionTruncations.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionTruncations(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.sourcetype")
+	require.Len(t, metrics["splunk.ingestion.truncations"], 1)
+	require.Equal(t, int64(37), metrics["splunk.ingestion.truncations"]["app:json"].Int())
+}
+
+func TestScrapeSkipsMetricsOfMissingRole(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		roles      string
+		dataPoints int
+		requests   int64
+	}{
+		{name: "indexer", roles: `["indexer","license_slave"]`, dataPoints: 1, requests: 1},
+		{name: "search head", roles: `["search_head","kv_store"]`, dataPoints: 0, requests: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests atomic.Int64
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.String() {
+				case "/services/server/info?output_mode=json":
+					_, _ = w.Write([]byte(`{"entry":[{"name":"server-info","content":{"serverName":"sh1","server_roles":` + tc.roles + `}}]}`))
+				case "/services/data/indexes-extended?output_mode=json&count=-1":
+					requests.Add(1)
+					_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"total_size":"42"}}]}`))
+				default:
+					http.NotFoundHandler().ServeHTTP(w, r)
+				}
+			}))
+			defer ts.Close()
+
+			metricsettings := metadata.MetricsBuilderConfig{}
+			metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+
+			host := &mockHost{
+				extensions: map[component.ID]component.Component{
+					component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+				},
+			}
+			scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), createMockConfig(typeIdx, ts.URL, metricsettings))
+			require.NoError(t, scraper.start(context.Background(), host))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.dataPoints, md.DataPointCount())
+			require.Equal(t, tc.requests, requests.Load())
+		})
+	}
+}
+
+func TestScrapeClusterIndexRFMetAtIngest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/cluster/master/indexes?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"is_searchable":"1","rf_met_at_ingest":"1"}},` +
+				`{"name":"firewall","content":{"is_searchable":"1","rf_met_at_ingest":"0"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterIndexRfMetAtIngest.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterIndexRFMetAtIngest(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(1), metrics["splunk.cluster.index.rf_met_at_ingest"]["main"].Int())
+	// the firewall index is replicated in steady state but fell short of its replication factor at ingest
+	require.Equal(t, int64(0), metrics["splunk.cluster.index.rf_met_at_ingest"]["firewall"].Int())
+}
+
+func TestScrapeWritesDiagnostics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/services/server/info":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"server-info","content":{"serverName":"idx1","server_roles":["indexer"]}}]}`))
+		case "/services/data/indexes-extended":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"total_size":"42","sessionKey":"s3cr3t-session"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+
+	// credentials embedded in the endpoint must not end up in the diagnostics either
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	u.User = url.UserPassword("admin", "hunter2")
+
+	cfg := createMockConfig(typeIdx, u.String(), metricsettings)
+	cfg.DiagnosticsDir = filepath.Join(t.TempDir(), "diagnostics")
+
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), host))
+
+	_, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(cfg.DiagnosticsDir, "scrape-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	b, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	var d scrapeDiagnostics
+	require.NoError(t, json.Unmarshal(b, &d))
+	require.NotEmpty(t, d.Requests)
+
+	var found bool
+	for _, ex := range d.Requests {
+		if strings.Contains(ex.URL, "/services/data/indexes-extended") {
+			found = true
+			require.Equal(t, http.StatusOK, ex.Status)
+			require.Contains(t, ex.Response, `"total_size":"42"`)
+			require.NotEmpty(t, ex.Duration)
+		}
+	}
+	require.True(t, found)
+	require.NotContains(t, string(b), "hunter2")
+	require.NotContains(t, string(b), "s3cr3t-session")
+}
+
+func TestScrapeKVStoreLookups(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>app</field><field>collection</field><field>active</field><field>slow</field></fieldOrder></meta><result offset="0"><field k="app"><value><text>search</text></value></field><field k="collection"><value><text>assets</text></value></field><field k="active"><value><text>120</text></value></field><field k="slow"><value><text>9</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkKvstoreLookupsActive.Enabled = true
+	metricsettings.Metrics.SplunkKvstoreLookupsSlow.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeKVStoreLookups(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.lookup.name")
+	require.Equal(t, int64(120), metrics["splunk.kvstore.lookups.active"]["assets"].Int())
+	require.Equal(t, int64(9), metrics["splunk.kvstore.lookups.slow"]["assets"].Int())
+}
+
+func TestScrapeRetriesEmptyResults(t *testing.T) {
+	var jobs atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `<response><sid>%d</sid></response>`, jobs.Add(1))
+			return
+		}
+		switch r.URL.Path {
+		// the first job completes before the data of its window has landed
+		case "/services/search/jobs/1/results":
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"></results>`))
+		case "/services/search/jobs/2/results":
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>sourcetype</field><field>truncations</field></fieldOrder></meta><result offset="0"><field k="sourcetype"><value><text>app:json</text></value></field><field k="truncations"><value><text>3</text></value></field></result></results>`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionTruncations.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.EmptyResultRetryDelays = map[string]time.Duration{"SplunkIngestionTruncations": 10 * time.Millisecond}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionTruncations(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, int64(2), jobs.Load())
+
+	metrics := emittedGauges(t, &scraper, "splunk.sourcetype")
+	require.Equal(t, int64(3), metrics["splunk.ingestion.truncations"]["app:json"].Int())
+}
+
+func TestScrapeFetchesIndexesExtendedOnce(t *testing.T) {
+	var requests atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			requests.Add(1)
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"total_size":"1","total_event_count":"10","total_bucket_count":"2","total_raw_size":"1"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedEventCount.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedBucketCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), requests.Load())
+	// the raw size is in the payload but its metric is disabled
+	require.Equal(t, 3, md.MetricCount())
+
+	// the listing is not carried over to the next scrape
+	_, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), requests.Load())
+}
+
+func TestScrapeConcurrently(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		active    int
+		maxActive int
+		jobs      atomic.Int64
+	)
+
+	// every search job takes a while to return its results
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `<response><sid>%d</sid></response>`, jobs.Add(1))
+			return
+		}
+		if r.URL.Path == "/services/data/indexes-extended" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"total_size":"1","total_event_count":"10"}}]}`))
+			return
+		}
+
+		mu.Lock()
+		active++
+		maxActive = max(maxActive, active)
+		mu.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>indexer_avg_kbps</field><field>run_time_avg</field><field>raw_data_write_seconds</field><field>service_cpu_seconds</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="indexer_avg_kbps"><value><text>1.5</text></value></field><field k="run_time_avg"><value><text>2.5</text></value></field><field k="raw_data_write_seconds"><value><text>3.5</text></value></field><field k="service_cpu_seconds"><value><text>4.5</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerAvgRunTime.Enabled = true
+	metricsettings.Metrics.SplunkIndexerRawWriteTime.Enabled = true
+	metricsettings.Metrics.SplunkIndexerCPUTime.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedEventCount.Enabled = true
+	metricsettings.Metrics.SplunkReceiverSearchRows.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.IdxEndpoint = cfg.CMEndpoint
+	cfg.MaxConcurrentScrapes = 4
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, maxActive, 1)
+	require.LessOrEqual(t, maxActive, 4)
+
+	// the data points of every scrape are merged, those of a metric recorded by several scrapes included
+	counts := map[string]int{}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		counts[ms.At(i).Name()] += ms.At(i).Gauge().DataPoints().Len()
+	}
+	require.Equal(t, map[string]int{
+		"splunk.indexer.avg.rate":                  1,
+		"splunk.scheduler.avg.run.time":            1,
+		"splunk.indexer.raw.write.time":            1,
+		"splunk.indexer.cpu.time":                  1,
+		"splunk.data.indexes.extended.total.size":  1,
+		"splunk.data.indexes.extended.event.count": 1,
+		"splunk.receiver.search.rows":              4,
+	}, counts)
+}
+
+func TestScrapeIndexHotBuckets(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"bucket_dirs":{"home":{"hot_bucket_count":"1"}}}},` +
+				`{"name":"firewall","content":{"bucket_dirs":{"home":{"hot_bucket_count":"3"}}}}]}`))
+		case "/services/data/indexes?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"maxHotBuckets":"10"}},` +
+				`{"name":"firewall","content":{"maxHotBuckets":"auto"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexHotBucketsCurrent.Enabled = true
+	metricsettings.Metrics.SplunkIndexHotBucketsMax.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeIndexesBucketHotWarmCount(context.Background(), now, errs)
+	scraper.scrapeIndexConfig(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	// firewall has every hot bucket its auto setting allows open
+	require.Equal(t, int64(3), metrics["splunk.index.hot_buckets.current"]["firewall"].Int())
+	require.Equal(t, int64(3), metrics["splunk.index.hot_buckets.max"]["firewall"].Int())
+	require.Equal(t, int64(1), metrics["splunk.index.hot_buckets.current"]["main"].Int())
+	require.Equal(t, int64(10), metrics["splunk.index.hot_buckets.max"]["main"].Int())
+}
+
+func TestScrapeUnitOverrides(t *testing.T) {
+	ts := createMockSearchServer(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>main</text></value></field><field k="By"><value><text>2500000</text></value></field></result></results>`)
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkLicenseIndexUsage.Enabled = true
+	metricsettings.Metrics.SplunkSchedulerAvgRunTime.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.UnitOverrides = map[string]string{
+		"splunk.license.index.usage": "MBy",
+		// a time cannot be converted to a size
+		"splunk.scheduler.avg.run.time": "MBy",
+	}
+	scraper := createMockScraper(t, cfg)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "splunk.license.index.usage" {
+			continue
+		}
+		require.Equal(t, "MBy", ms.At(i).Unit())
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		require.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+		require.InDelta(t, 2.5, dp.DoubleValue(), 1e-9)
+		return
+	}
+	t.Fatal("splunk.license.index.usage was not emitted")
+}
+
+func TestScrapeRejectedSearch(t *testing.T) {
+	var dispatched atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		dispatched.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><messages><msg type="FATAL">Error in 'rest' command: You do not have the capability to run this search.</msg></messages></response>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkLicenseIndexUsage.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	start := time.Now()
+	_, err := scraper.scrape(context.Background())
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.ErrorIs(t, err, errBadRequest)
+	require.NotErrorIs(t, err, errMaxSearchWaitTimeExceeded)
+	require.ErrorContains(t, err, "You do not have the capability to run this search.")
+	require.EqualValues(t, 1, dispatched.Load())
+}
+
+func TestScrapeLongRunningSearches(t *testing.T) {
+	// two of the searches alice ran took longer than the threshold and one did not
+	runtimes := []float64{75, 240, 12}
+	var threshold atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			form, _ := url.ParseQuery(string(body))
+			if m := regexp.MustCompile(`total_run_time>(\d+)`).FindStringSubmatch(form.Get("search")); m != nil {
+				v, _ := strconv.ParseInt(m[1], 10, 64)
+				threshold.Store(v)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+
+		// count the searches as Splunk would for the threshold the search was dispatched with
+		var long int
+		for _, runtime := range runtimes {
+			if runtime > float64(threshold.Load()) {
+				long++
+			}
+		}
+		_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>user</field><field>long_running</field></fieldOrder></meta><result offset="0"><field k="user"><value><text>alice</text></value></field><field k="long_running"><value><text>%d</text></value></field></result></results>`, long)
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSearchesLongRunningCount.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.SearchVariables = map[string]string{"LongRunningSearchSeconds": "60"}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeLongRunningSearches(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, int64(60), threshold.Load())
+
+	metrics := emittedGauges(t, &scraper, "splunk.user")
+	require.Len(t, metrics["splunk.searches.long_running.count"], 1)
+	require.Equal(t, int64(2), metrics["splunk.searches.long_running.count"]["alice"].Int())
+}
+
+func TestScrapeSourcetypeEvents(t *testing.T) {
+	// the events of access_combined are spread across two indexers, those of syslog are on one
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>sourcetype</field><field>splunk_server</field><field>events</field></fieldOrder></meta><result offset="0"><field k="sourcetype"><value><text>access_combined</text></value></field><field k="splunk_server"><value><text>idx1</text></value></field><field k="events"><value><text>1200</text></value></field></result><result offset="0"><field k="sourcetype"><value><text>access_combined</text></value></field><field k="splunk_server"><value><text>idx2</text></value></field><field k="events"><value><text>800</text></value></field></result><result offset="0"><field k="sourcetype"><value><text>syslog</text></value></field><field k="splunk_server"><value><text>idx1</text></value></field><field k="events"><value><text>300</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSourcetypeEvents.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSourcetypeEvents(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.sourcetype")
+	require.Len(t, metrics["splunk.sourcetype.events"], 2)
+	require.Equal(t, int64(2000), metrics["splunk.sourcetype.events"]["access_combined"].Int())
+	require.Equal(t, int64(300), metrics["splunk.sourcetype.events"]["syslog"].Int())
+}
+
+func TestScrapeClusterPeerChurn(t *testing.T) {
+	// idx2 joined the cluster, left it when restarted and joined it again
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>peer</field><field>joins</field><field>leaves</field></fieldOrder></meta><result offset="0"><field k="peer"><value><text>idx2</text></value></field><field k="joins"><value><text>2</text></value></field><field k="leaves"><value><text>1</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterPeerJoins.Enabled = true
+	metricsettings.Metrics.SplunkClusterPeerLeaves.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterPeerChurn(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.peer")
+	require.Equal(t, int64(2), metrics["splunk.cluster.peer_joins"]["idx2"].Int())
+	require.Equal(t, int64(1), metrics["splunk.cluster.peer_leaves"]["idx2"].Int())
+}
+
+func TestScrapeClusterBucketSyncLag(t *testing.T) {
+	// idx2 has not replicated a bucket for two hours while idx1 keeps up
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>peer</field><field>lag_seconds</field></fieldOrder></meta>` +
+			`<result offset="0"><field k="peer"><value><text>idx1</text></value></field><field k="lag_seconds"><value><text>30</text></value></field></result>` +
+			`<result offset="1"><field k="peer"><value><text>idx2</text></value></field><field k="lag_seconds"><value><text>7200</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkClusterBucketSyncLagSeconds.Enabled = true
+
+	// without a cluster master the search runs against the indexer
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeClusterBucketSyncLag(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.peer")
+	require.Equal(t, int64(30), metrics["splunk.cluster.bucket_sync.lag_seconds"]["idx1"].Int())
+	require.Equal(t, int64(7200), metrics["splunk.cluster.bucket_sync.lag_seconds"]["idx2"].Int())
+}
+
+func TestScrapeConnectionTimings(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/server/info?output_mode=json":
+			_, _ = w.Write([]byte(`{"entry":[{"name":"server-info","content":{"serverName":"idx1","server_roles":["indexer"]}}]}`))
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			// Splunk taking its time to answer
+			time.Sleep(50 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"entry":[{"name":"main","content":{"total_size":"42"}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+	metricsettings.Metrics.SplunkReceiverRequestDNSTime.Enabled = true
+	metricsettings.Metrics.SplunkReceiverRequestTLSHandshakeTime.Enabled = true
+	metricsettings.Metrics.SplunkReceiverRequestFirstByteTime.Enabled = true
+
+	cfg := createMockConfig(typeIdx, ts.URL, metricsettings)
+	cfg.IdxEndpoint.TLSSetting.InsecureSkipVerify = true
+	host := &mockHost{
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("basicauth", "client"): auth.NewClient(),
+		},
+	}
+	scraper := newSplunkMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), host))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	timings := make(map[string]float64)
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if !strings.HasPrefix(ms.At(i).Name(), "splunk.receiver.request.") {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			endpoint, _ := dps.At(j).Attributes().Get("splunk.endpoint")
+			require.Equal(t, "indexer", endpoint.Str())
+			timings[ms.At(i).Name()] = dps.At(j).DoubleValue()
+		}
+	}
+
+	require.Greater(t, timings["splunk.receiver.request.tls_handshake.time"], 0.0)
+	require.GreaterOrEqual(t, timings["splunk.receiver.request.first_byte.time"], 0.05)
+	// the test server is reached by IP address, without a DNS lookup
+	require.NotContains(t, timings, "splunk.receiver.request.dns.time")
+}
+
+func TestScrapeReturnsAtContextDeadline(t *testing.T) {
+	// the search never completes, so the scraper keeps waiting between polls
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerAvgRate(ctx, pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.ErrorIs(t, errs.Combine(), context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestScrapeCancelsAbandonedSearch(t *testing.T) {
+	// the search never completes, so the scraper gives up on it when the scrape is cancelled
+	deleted := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+		case http.MethodDelete:
+			deleted <- r.URL.Path
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerAvgRate(ctx, pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.ErrorIs(t, errs.Combine(), context.DeadlineExceeded)
+
+	select {
+	case path := <-deleted:
+		require.Equal(t, "/services/search/jobs/123", path)
+	default:
+		t.Fatal("the abandoned search job was not deleted")
+	}
+}
+
+func TestScrapeResultsInChunks(t *testing.T) {
+	sourcetypes := []string{"access_combined", "syslog", "json", "csv", "stash"}
+	var mu sync.Mutex
+	var offsets []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+
+		// serve the window of the results asked for, as splunk does
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		mu.Lock()
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		mu.Unlock()
+
+		var b strings.Builder
+		b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>sourcetype</field><field>truncations</field></fieldOrder></meta>`)
+		for i := offset; i < min(offset+count, len(sourcetypes)); i++ {
+			fmt.Fprintf(&b, `<result offset="%d"><field k="sourcetype"><value><text>%s</text></value></field><field k="truncations"><value><text>%d</text></value></field></result>`, i, sourcetypes[i], i+1)
+		}
+		b.WriteString(`</results>`)
+		_, _ = w.Write([]byte(b.String()))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIngestionTruncations.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.ResultsChunkSize = 2
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIngestionTruncations(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Equal(t, []string{"0", "2", "4"}, offsets)
+
+	metrics := emittedGauges(t, &scraper, "splunk.sourcetype")
+	require.Len(t, metrics["splunk.ingestion.truncations"], len(sourcetypes))
+	for i, sourcetype := range sourcetypes {
+		require.Equal(t, int64(i+1), metrics["splunk.ingestion.truncations"][sourcetype].Int())
+	}
+}
+
+func TestScrapeChunkedResults(t *testing.T) {
+	results := `<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>indexer_avg_kbps</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="indexer_avg_kbps"><value><text>12.5</text></value></field></result></results>`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		// flushing before the body is complete sends it chunked, without a Content-Length
+		_, _ = w.Write([]byte(results[:len(results)/2]))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(results[len(results)/2:]))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerAvgRate(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Equal(t, 12.5, metrics["splunk.indexer.avg.rate"]["idx1"].Double())
+
+	// a response reporting no length along with a body is parsed all the same
+	sr := searchResponse{}
+	res := &http.Response{StatusCode: http.StatusOK, ContentLength: 0, Body: io.NopCloser(strings.NewReader(results))}
+	require.NoError(t, unmarshallSearchReq(res, &sr))
+	require.Len(t, sr.Results, 1)
+	require.Len(t, sr.Results[0], 2)
+}
+
+func TestScrapeMultipleRows(t *testing.T) {
+	// the fields of each row come in any order, and a row may lack some of them
+	results := `<?xml version="1.0" encoding="UTF-8"?><results preview="0">` +
+		`<result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="agg_queue_ratio"><value><text>0.1</text></value></field><field k="pipeline_sets"><value><text>1</text></value></field></result>` +
+		`<result offset="1"><field k="pipeline_sets"><value><text>2</text></value></field><field k="agg_queue_ratio"><value><text>0.2</text></value></field><field k="host"><value><text>idx2</text></value></field></result>` +
+		`<result offset="2"><field k="agg_queue_ratio"><value><text>0.3</text></value></field><field k="host"><value><text>idx3</text></value></field></result>` +
+		`</results>`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(results))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkAggregationQueueRatio.Enabled = true
+	metricsettings.Metrics.SplunkPipelineSetCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerPipelineQueues(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Equal(t, map[string]pcommon.Value{
+		"idx1": pcommon.NewValueDouble(0.1),
+		"idx2": pcommon.NewValueDouble(0.2),
+		"idx3": pcommon.NewValueDouble(0.3),
+	}, metrics["splunk.aggregation.queue.ratio"])
+	require.Equal(t, map[string]pcommon.Value{
+		"idx1": pcommon.NewValueInt(1),
+		"idx2": pcommon.NewValueInt(2),
+	}, metrics["splunk.pipeline.set.count"])
+}
+
+func TestScrapeSampling(t *testing.T) {
+	var dispatched atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			dispatched.Add(1)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>indexer_avg_kbps</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="indexer_avg_kbps"><value><text>12.5</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.Sampling = map[string]float64{"splunk.indexer.avg.rate": 0.5}
+	scraper := createMockScraper(t, cfg)
+	scraper.sampler = rand.New(rand.NewSource(1)) //nolint:gosec // a seeded sampler makes the test deterministic
+
+	const cycles = 200
+	var collected int64
+	for i := 0; i < cycles; i++ {
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		if md.MetricCount() > 0 {
+			collected++
+		}
+	}
+
+	// the search only runs on the intervals the metric is collected on
+	require.Equal(t, collected, dispatched.Load())
+	require.InDelta(t, cycles/2, collected, cycles/10)
+	require.True(t, scraper.conf.MetricsBuilderConfig.Metrics.SplunkIndexerAvgRate.Enabled)
+}
+
+func TestScrapeSearchPollInterval(t *testing.T) {
+	var polls atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		// the results are ready on the third poll
+		if polls.Add(1) < 3 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>indexer_avg_kbps</field></fieldOrder></meta><result offset="0"><field k="host"><value><text>idx1</text></value></field><field k="indexer_avg_kbps"><value><text>12.5</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.SearchPollInitialInterval = 10 * time.Millisecond
+	cfg.SearchPollMaxInterval = 10 * time.Millisecond
+	scraper := createMockScraper(t, cfg)
+
+	start := time.Now()
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerAvgRate(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Less(t, time.Since(start), time.Second)
+	require.EqualValues(t, 3, polls.Load())
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.InDelta(t, 12.5, metrics["splunk.indexer.avg.rate"]["idx1"].Double(), 1e-9)
+}
+
+func TestScrapeIndexSizeByState(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"bucket_dirs":{` +
+				`"home":{"hot_bucket_size":"1.5","warm_bucket_size":"20"},` +
+				`"cold":{"bucket_size":"300"}}}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexSizeHotBytes.Enabled = true
+	metricsettings.Metrics.SplunkIndexSizeWarmBytes.Enabled = true
+	metricsettings.Metrics.SplunkIndexSizeColdBytes.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexesSizeByState(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, int64(1572864), metrics["splunk.index.size.hot_bytes"]["main"].Int())
+	require.Equal(t, int64(20971520), metrics["splunk.index.size.warm_bytes"]["main"].Int())
+	require.Equal(t, int64(314572800), metrics["splunk.index.size.cold_bytes"]["main"].Int())
+}
+
+func TestScrapeIndexesMissingFields(t *testing.T) {
+	// web reports neither its size nor its bucket count, and one entry has no name
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.String() {
+		case "/services/data/indexes-extended?output_mode=json&count=-1":
+			_, _ = w.Write([]byte(`{"entry":[` +
+				`{"name":"main","content":{"total_size":"2","total_raw_size":"4","total_bucket_count":"10","totalEventCount":100}},` +
+				`{"name":"web","content":{"totalEventCount":50}},` +
+				`{"content":{"total_size":"999","total_raw_size":"999","total_bucket_count":"999","totalEventCount":999}},` +
+				`{"name":"security","content":{"total_size":"1","total_raw_size":"3","total_bucket_count":"5","totalEventCount":25}}]}`))
+		default:
+			http.NotFoundHandler().ServeHTTP(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedRawSize.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedBucketCount.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedEventCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeIndexesTotalSize(context.Background(), now, errs)
+	scraper.scrapeIndexesRawSize(context.Background(), now, errs)
+	scraper.scrapeIndexesBucketCount(context.Background(), now, errs)
+	scraper.scrapeIndexesEventCount(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	for _, name := range []string{"splunk.data.indexes.extended.total.size", "splunk.data.indexes.extended.raw.size", "splunk.data.indexes.extended.bucket.count"} {
+		require.Len(t, metrics[name], 2, name)
+		require.NotContains(t, metrics[name], "web", name)
+	}
+	require.Equal(t, int64(2097152), metrics["splunk.data.indexes.extended.total.size"]["main"].Int())
+	require.Equal(t, int64(1048576), metrics["splunk.data.indexes.extended.total.size"]["security"].Int())
+	require.Equal(t, int64(5), metrics["splunk.data.indexes.extended.bucket.count"]["security"].Int())
+	require.Len(t, metrics["splunk.data.indexes.extended.event.count"], 3)
+	require.Equal(t, int64(50), metrics["splunk.data.indexes.extended.event.count"]["web"].Int())
+}
+
+func TestScrapeSearchPollBackoff(t *testing.T) {
+	var mu sync.Mutex
+	var polls []time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		// the search never completes
+		mu.Lock()
+		polls = append(polls, time.Now())
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexerAvgRate.Enabled = true
+
+	cfg := createMockConfig(typeCm, ts.URL, metricsettings)
+	cfg.ScraperControllerSettings.Timeout = 700 * time.Millisecond
+	cfg.SearchPollInitialInterval = 40 * time.Millisecond
+	cfg.SearchPollMaxInterval = 160 * time.Millisecond
+	scraper := createMockScraper(t, cfg)
+
+	start := time.Now()
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexerAvgRate(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.ErrorIs(t, errs.Combine(), errMaxSearchWaitTimeExceeded)
+	// the last wait is cut short by the search timeout
+	require.Less(t, time.Since(start), time.Second)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.GreaterOrEqual(t, len(polls), 4)
+	// the waits double from the initial interval up to the max, give or take their jitter
+	for i, expected := range []time.Duration{40 * time.Millisecond, 80 * time.Millisecond, 160 * time.Millisecond} {
+		require.GreaterOrEqual(t, polls[i+1].Sub(polls[i]), expected*4/5)
+	}
+	for i := 1; i < len(polls); i++ {
+		require.Less(t, polls[i].Sub(polls[i-1]), 160*time.Millisecond*6/5+100*time.Millisecond)
+	}
+}
+
+func TestScrapeHealthMetrics(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		status  int
+		success int64
+	}{
+		{name: "succeeded", status: http.StatusOK, success: 1},
+		{name: "failed", status: http.StatusInternalServerError, success: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.status != http.StatusOK {
+					w.WriteHeader(tc.status)
+					return
+				}
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+					return
+				}
+				_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>indexname</field><field>By</field></fieldOrder></meta><result offset="0"><field k="indexname"><value><text>main</text></value></field><field k="By"><value><text>42</text></value></field></result></results>`))
+			}))
+			defer ts.Close()
+
+			metricsettings := metadata.MetricsBuilderConfig{}
+			metricsettings.Metrics.SplunkLicenseIndexUsage.Enabled = true
+			metricsettings.Metrics.SplunkScrapeDurationSeconds.Enabled = true
+			metricsettings.Metrics.SplunkScrapeSuccess.Enabled = true
+
+			scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+			md, err := scraper.scrape(context.Background())
+			if tc.success == 0 {
+				require.ErrorIs(t, err, errServer)
+				// the collector only delivers the metrics of a scrape returning a partial error
+				require.True(t, scrapererror.IsPartialScrapeError(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			metrics := make(map[string]pmetric.NumberDataPoint)
+			ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < ms.Len(); i++ {
+				metrics[ms.At(i).Name()] = ms.At(i).Gauge().DataPoints().At(0)
+			}
+			require.Equal(t, tc.success, metrics["splunk.scrape.success"].IntValue())
+			require.Greater(t, metrics["splunk.scrape.duration_seconds"].DoubleValue(), 0.0)
+			// the metrics of a failed scrape are dropped
+			_, ok := metrics["splunk.license.index.usage"]
+			require.Equal(t, tc.success == 1, ok)
+		})
+	}
+}
+
+func TestScrapeConcurrentSearches(t *testing.T) {
+	var search string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			search = string(body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>concurrent_searches</field></fieldOrder></meta>` +
+			`<result offset="0"><field k="host"><value><text>sh1</text></value></field><field k="concurrent_searches"><value><text>7</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSearchesConcurrent.Enabled = true
+
+	// the search head is not configured
+	scraper := createMockScraper(t, createMockConfig(typeIdx, ts.URL, metricsettings))
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeConcurrentSearches(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Empty(t, search)
+
+	scraper = createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+	scraper.scrapeConcurrentSearches(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+	require.Contains(t, search, "resource-usage/splunk-processes")
+
+	metrics := emittedGauges(t, &scraper, "splunk.host")
+	require.Equal(t, int64(7), metrics["splunk.searches.concurrent"]["sh1"].Int())
+}
+
+func TestScrapeDispatchArtifactsPendingDeletion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.String() != "/services/server/status/dispatch-artifacts?output_mode=json" {
+			http.NotFoundHandler().ServeHTTP(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entry":[{"name":"dispatch-artifacts","content":{"pending_deletion_count":"42"}}]}`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDispatchArtifactsPendingDeletion.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeSh, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeDispatchArtifactsPendingDeletion(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "")
+	require.Equal(t, int64(42), metrics["splunk.dispatch.artifacts.pending_deletion"][""].Int())
+}
+
+func TestScrapeSchedulerSkippedCount(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0"><meta><fieldOrder><field>host</field><field>reason</field><field>skipped_count</field></fieldOrder></meta>` +
+			`<result offset="0"><field k="host"><value><text>sh1</text></value></field><field k="reason"><value><text>The maximum number of concurrent historical scheduled searches on this instance has been reached</text></value></field><field k="skipped_count"><value><text>5</text></value></field></result>` +
+			`<result offset="1"><field k="host"><value><text>sh1</text></value></field><field k="reason"><value><text>(UNKNOWN)</text></value></field><field k="skipped_count"><value><text>1</text></value></field></result></results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkSchedulerSkippedCount.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeSchedulerSkippedCountByHost(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.scheduler.skip_reason")
+	require.Equal(t, map[string]pcommon.Value{
+		"The maximum number of concurrent historical scheduled searches on this instance has been reached": pcommon.NewValueInt(5),
+		"(UNKNOWN)": pcommon.NewValueInt(1),
+	}, metrics["splunk.scheduler.skipped.count"])
+}
+
+func TestScrapeIndexesAcrossIndexers(t *testing.T) {
+	// both indexers hold main, while web and security are each held by one of them
+	newIndexer := func(entries string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.String() != "/services/data/indexes-extended?output_mode=json&count=-1" {
+				http.NotFoundHandler().ServeHTTP(w, r)
+				return
+			}
+			_, _ = w.Write([]byte(`{"entry":[` + entries + `]}`))
+		}))
+	}
+	idx1 := newIndexer(`{"name":"main","content":{"total_size":"1.5","total_bucket_count":"4","totalEventCount":100,"maxWarmDBCount":10,"bucket_dirs":{"home":{"warm_bucket_count":"3"}}}},` +
+		`{"name":"web","content":{"total_size":"1","total_bucket_count":"2","totalEventCount":20}}`)
+	defer idx1.Close()
+	idx2 := newIndexer(`{"name":"main","content":{"total_size":"0.5","total_bucket_count":"6","totalEventCount":50,"maxWarmDBCount":10,"bucket_dirs":{"home":{"warm_bucket_count":"5"}}}},` +
+		`{"name":"security","content":{"total_size":"2","total_bucket_count":"1","totalEventCount":5}}`)
+	defer idx2.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkDataIndexesExtendedTotalSize.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedBucketCount.Enabled = true
+	metricsettings.Metrics.SplunkDataIndexesExtendedEventCount.Enabled = true
+	metricsettings.Metrics.SplunkIndexBucketUtilizationRatio.Enabled = true
+
+	cfg := createMockConfig(typeIdx, idx1.URL, metricsettings)
+	cfg.AdditionalIndexers = []confighttp.ClientConfig{{Endpoint: idx2.URL, Auth: cfg.IdxEndpoint.Auth}}
+	scraper := createMockScraper(t, cfg)
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.scrapeIndexesTotalSize(context.Background(), now, errs)
+	scraper.scrapeIndexesBucketCount(context.Background(), now, errs)
+	scraper.scrapeIndexesEventCount(context.Background(), now, errs)
+	scraper.scrapeIndexBucketUtilization(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := emittedGauges(t, &scraper, "splunk.index.name")
+	require.Equal(t, map[string]pcommon.Value{
+		"main":     pcommon.NewValueInt(2 * 1024 * 1024),
+		"web":      pcommon.NewValueInt(1024 * 1024),
+		"security": pcommon.NewValueInt(2 * 1024 * 1024),
+	}, metrics["splunk.data.indexes.extended.total.size"])
+	require.Equal(t, int64(10), metrics["splunk.data.indexes.extended.bucket.count"]["main"].Int())
+	require.Equal(t, int64(150), metrics["splunk.data.indexes.extended.event.count"]["main"].Int())
+	require.Len(t, metrics["splunk.data.indexes.extended.event.count"], 3)
+	// the warm buckets of the tier against the limits of both indexers
+	require.Equal(t, 0.4, metrics["splunk.index.bucket_utilization_ratio"]["main"].Double())
+}
+
+func TestScrapeIndexEventsPerSecond(t *testing.T) {
+	result := func(offset int, index, host, eps string) string {
+		return fmt.Sprintf(`<result offset="%d"><field k="indexname"><value><text>%s</text></value></field><field k="host"><value><text>%s</text></value></field><field k="events_per_second"><value><text>%s</text></value></field></result>`, offset, index, host, eps)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><response><sid>123</sid></response>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><results preview="0">` +
+			result(0, "main", "idx1", "12.5") + result(1, "main", "idx2", "3.25") +
+			result(2, "web", "idx1", "0.5") + result(3, "web", "idx2", "40") + `</results>`))
+	}))
+	defer ts.Close()
+
+	metricsettings := metadata.MetricsBuilderConfig{}
+	metricsettings.Metrics.SplunkIndexEventsPerSecond.Enabled = true
+
+	scraper := createMockScraper(t, createMockConfig(typeCm, ts.URL, metricsettings))
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeIndexEventsPerSecond(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	// each series is identified by both its index and its host
+	series := map[string]float64{}
+	m := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "splunk.index.events_per_second", m.Name())
+	for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+		dp := m.Gauge().DataPoints().At(i)
+		index, _ := dp.Attributes().Get("splunk.index.name")
+		host, _ := dp.Attributes().Get("splunk.host")
+		series[index.Str()+"/"+host.Str()] = dp.DoubleValue()
+	}
+	require.Equal(t, map[string]float64{
+		"main/idx1": 12.5,
+		"main/idx2": 3.25,
+		"web/idx1":  0.5,
+		"web/idx2":  40,
+	}, series)
+}
@@ -3,33 +3,130 @@
 
 package splunkenterprisereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver"
 
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver/internal/metadata"
+)
+
 // metric name and its associated search as a key value pair
 var searchDict = map[string]string{
 	`SplunkLicenseIndexUsageSearch`:       `search=search earliest=-10m latest=now index=_internal source=*license_usage.log type="Usage"| fields idx, b| eval indexname = if(len(idx)=0 OR isnull(idx),"(UNKNOWN)",idx)| stats sum(b) as b by indexname| eval By=round(b, 9)| fields indexname, By`,
 	`SplunkIndexerAvgRate`:                `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_indexer splunk_server_group="dmc_group_indexer" /services/server/introspection/indexer | eval average_KBps = round(average_KBps, 0) | eval status = if((reason == ".") OR (reason == "") OR isnull(reason), status, status.": ".reason) | fields splunk_server, average_KBps, status] | eval host = splunk_server | stats avg(average_KBps) as "indexer_avg_kbps", values(status) as "status" by host | fields host, indexer_avg_kbps`,
 	`SplunkSchedulerAvgExecLatencySearch`: `search=search earliest=-10m latest=now index=_internal host=* sourcetype=scheduler (status="completed" OR status="skipped" OR status="deferred" OR status="success") | eval window_time = if(isnull('window_time'), 0, 'window_time') | eval execution_latency = max(0.00, ('dispatch_time' - (scheduled_time %2B window_time))) | stats avg(execution_latency) AS avg_exec_latency by host | eval host = if(isnull(host), "(UNKNOWN)", host) | eval latency_avg_exec = round(avg_exec_latency, 2) | fields host, latency_avg_exec`,
-	`SplunkSchedulerCompletionRatio`:      `search=search earliest=-10m latest=now index=_internal host=* sourcetype=scheduler (status="completed" OR status="skipped" OR status="deferred" OR status="success") | stats count(eval(status=="completed" OR status=="skipped" OR status="success")) AS total_exec, count(eval(status=="skipped")) AS skipped_exec by host | eval completion_ratio = round((1-(skipped_exec / total_exec)) * 100, 2) | fields host, completion_ratio`,
+	`SplunkSchedulerCompletionRatio`:      `search=search earliest=-10m latest=now index=_internal host=* sourcetype=scheduler (status="completed" OR status="skipped" OR status="deferred" OR status="success") | stats count(eval(status=="completed" OR status=="skipped" OR status="success")) AS total_exec, count(eval(status=="skipped")) AS skipped_exec by host | eval completion_ratio = round((1-(skipped_exec / total_exec)) * 100, 2) | fields host, completion_ratio, skipped_exec`,
+	`SplunkSchedulerSkippedCount`:         `search=search earliest=-10m latest=now index=_internal host=* sourcetype=scheduler status="skipped" | eval host = if(isnull(host), "(UNKNOWN)", host) | eval reason = if(isnull(reason), "(UNKNOWN)", reason) | stats count AS skipped_count by host, reason | fields host, reason, skipped_count`,
 	`SplunkSchedulerAvgRunTime`:           `search=search earliest=-10m latest=now index=_internal host=* sourcetype=scheduler (status="completed" OR status="skipped" OR status="deferred" OR status="success") | eval runTime = avg(run_time) | stats avg(runTime) AS runTime by host | eval host = if(isnull(host), "(UNKNOWN)", host) | eval run_time_avg = round(runTime, 2) | fields host, run_time_avg`,
 	`SplunkIndexerRawWriteSeconds`:        `search=search earliest=-10m latest=now index=_internal host=* source=*metrics.log sourcetype=splunkd group=pipeline name=indexerpipe processor=indexer | eval ingest_pipe = if(isnotnull(ingest_pipe), ingest_pipe, "none") | search ingest_pipe=* | stats sum(write_cpu_seconds) AS "raw_data_write_seconds" by host | fields host, raw_data_write_seconds`,
 	`SplunkIndexerCpuSeconds`:             `search=search earliest=-10m latest=now index=_internal host=* source=*metrics.log sourcetype=splunkd group=pipeline name=indexerpipe processor=indexer | eval ingest_pipe = if(isnotnull(ingest_pipe), ingest_pipe, "none") | search ingest_pipe=* | stats sum(service_cpu_seconds) AS "service_cpu_seconds" by host | fields host, service_cpu_seconds`,
-	`SplunkIoAvgIops`:                     `search=search earliest=-10m latest=now index=_introspection sourcetype=splunk_resource_usage component=IOStats host=* | eval mount_point = 'data.mount_point' | eval reads_ps = 'data.reads_ps' | eval writes_ps = 'data.writes_ps' | eval interval = 'data.interval' | eval total_io = reads_ps %2B writes_ps| eval op_count = (interval * total_io)| search data.mount_point="/opt/splunk/var" | stats avg(op_count) as iops by host| eval iops = round(iops) | fields host, iops`,
+	`SplunkIoAvgIops`:                     `search=search earliest=-10m latest=now index=_introspection sourcetype=splunk_resource_usage component=IOStats host=* | eval mount_point = 'data.mount_point' | eval reads_ps = 'data.reads_ps' | eval writes_ps = 'data.writes_ps' | eval interval = 'data.interval' | eval total_io = reads_ps %2B writes_ps| eval op_count = (interval * total_io)| search data.mount_point="{{.MountPoint}}" | stats avg(op_count) as iops by host| eval iops = round(iops) | fields host, iops`,
+	`SplunkIndexEventsPerSecond`:          `search=search earliest=-10m latest=now index=_internal host=* source=*metrics.log sourcetype=splunkd group=per_index_thruput | eval indexname = if(isnull(series), "(UNKNOWN)", series) | stats sum(ev) AS events by indexname, host | eval events_per_second = round(events / 600, 2) | fields indexname, host, events_per_second`,
 	`SplunkPipelineQueues`:                `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_indexer splunk_server_group="dmc_group_indexer" /services/server/introspection/queues | search title=parsingQueue* OR title=aggQueue* OR title=typingQueue* OR title=indexQueue* | eval fill_perc=round(current_size_bytes / max_size_bytes * 100,2) | fields splunk_server, title, fill_perc | rex field=title %22%28%3F%3Cqueue_name%3E%5E%5Cw%2B%29%28%3F%3A%5C.%28%3F%3Cpipeline_number%3E%5Cd%2B%29%29%3F%22 | eval fill_perc = if(isnotnull(pipeline_number), "pset".pipeline_number.": ".fill_perc, fill_perc) | chart values(fill_perc) over splunk_server by queue_name | eval pset_count = mvcount(parsingQueue)] | eval host = splunk_server | stats sum(pset_count) as "pipeline_sets", sum(parsingQueue) as "parse_queue_ratio", sum(aggQueue) as "agg_queue_ratio", sum(typingQueue) as "typing_queue_ratio", sum(indexQueue) as "index_queue_ratio" by host | fields host, pipeline_sets, parse_queue_ratio, agg_queue_ratio, typing_queue_ratio, index_queue_ratio`,
 	`SplunkBucketsSearchableStatus`:       `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_cluster_master splunk_server_group=* /services/cluster/master/peers | eval splunk_server = label | fields splunk_server, label, is_searchable, status, site, bucket_count, host_port_pair, last_heartbeat, replication_port, base_generation_id, title, bucket_count_by_index.* | eval is_searchable = if(is_searchable == 1 or is_searchable == "1", "Yes", "No")] | sort - last_heartbeat | search label="***" | search is_searchable="*" | search status="*" | search site="*" | eval host = splunk_server | stats values(is_searchable) as is_searchable, values(status) as status, avg(bucket_count) as bucket_count by host | fields host, is_searchable, status, bucket_count`,
-	`SplunkIndexesData`:                   `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_indexer splunk_server_group="*" /services/data/indexes] | join title splunk_server type=outer [ rest splunk_server_group=dmc_group_indexer splunk_server_group="*" /services/data/indexes-extended ] | eval elapsedTime = now() - strptime(minTime,"%25Y-%25m-%25dT%25H%3A%25M%3A%25S%25z") | eval dataAge = ceiling(elapsedTime / 86400) | eval indexSizeGB = if(currentDBSizeMB >= 1 AND totalEventCount >=1, currentDBSizeMB/1024, null()) | eval maxSizeGB = maxTotalDataSizeMB / 1024 | eval sizeUsagePerc = indexSizeGB / maxSizeGB * 100 | stats dc(splunk_server) AS splunk_server_count count(indexSizeGB) as "non_empty_instances" sum(indexSizeGB) AS total_size_gb avg(indexSizeGB) as average_size_gb avg(sizeUsagePerc) as average_usage_perc median(dataAge) as median_data_age max(dataAge) as oldest_data_age latest(bucket_dirs.home.warm_bucket_count) as warm_bucket_count latest(bucket_dirs.home.hot_bucket_count) as hot_bucket_count by title, datatype | eval warm_bucket_count = if(isnotnull(warm_bucket_count), warm_bucket_count, 0)| eval hot_bucket_count = if(isnotnull(hot_bucket_count), hot_bucket_count, 0)| eval bucket_count = (warm_bucket_count %2B hot_bucket_count)| eval total_size_gb = if(isnotnull(total_size_gb), round(total_size_gb, 2), 0) | eval average_size_gb = if(isnotnull(average_size_gb), round(average_size_gb, 2), 0) | eval average_usage_perc = if(isnotnull(average_usage_perc), round(average_usage_perc, 2), 0) | eval median_data_age = if(isNum(median_data_age), median_data_age, 0) | eval oldest_data_age = if(isNum(oldest_data_age), oldest_data_age, 0) | fields title splunk_server_count non_empty_instances total_size_gb average_size_gb average_usage_perc median_data_age bucket_count warm_bucket_count hot_bucket_count`,
+	`SplunkIndexesData`:                   `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_indexer splunk_server_group="*" /services/data/indexes] | join title splunk_server type=outer [ rest splunk_server_group=dmc_group_indexer splunk_server_group="*" /services/data/indexes-extended ] | eval elapsedTime = now() - strptime(minTime,"%25Y-%25m-%25dT%25H%3A%25M%3A%25S%25z") | eval dataAge = ceiling(elapsedTime / 86400) | eval indexSizeGB = if(currentDBSizeMB >= 1 AND totalEventCount >=1, currentDBSizeMB/1024, null()) | eval maxSizeGB = maxTotalDataSizeMB / 1024 | eval sizeUsagePerc = indexSizeGB / maxSizeGB * 100 | stats dc(splunk_server) AS splunk_server_count count(indexSizeGB) as "non_empty_instances" sum(indexSizeGB) AS total_size_gb avg(indexSizeGB) as average_size_gb avg(sizeUsagePerc) as average_usage_perc median(dataAge) as median_data_age max(dataAge) as oldest_data_age latest(bucket_dirs.home.warm_bucket_count) as warm_bucket_count latest(bucket_dirs.home.hot_bucket_count) as hot_bucket_count max(frozenTimePeriodInSecs) as frozen_time_period_secs by title, datatype | eval warm_bucket_count = if(isnotnull(warm_bucket_count), warm_bucket_count, 0)| eval hot_bucket_count = if(isnotnull(hot_bucket_count), hot_bucket_count, 0)| eval bucket_count = (warm_bucket_count %2B hot_bucket_count)| eval total_size_gb = if(isnotnull(total_size_gb), round(total_size_gb, 2), 0) | eval average_size_gb = if(isnotnull(average_size_gb), round(average_size_gb, 2), 0) | eval average_usage_perc = if(isnotnull(average_usage_perc), round(average_usage_perc, 2), 0) | eval median_data_age = if(isNum(median_data_age), median_data_age, 0) | eval oldest_data_age = if(isNum(oldest_data_age), oldest_data_age, 0) | eval frozen_time_period_secs = if(isNum(frozen_time_period_secs), frozen_time_period_secs, 0) | fields title splunk_server_count non_empty_instances total_size_gb average_size_gb average_usage_perc median_data_age bucket_count warm_bucket_count hot_bucket_count frozen_time_period_secs`,
 	`SplunkIndexesBucketCounts`:           `search=search earliest=-10m latest=now index=_telemetry | stats count(index) | appendcols [| rest splunk_server_group=dmc_group_cluster_master splunk_server_group=* /services/cluster/master/indexes | fields title, is_searchable, replicated_copies_tracker*, searchable_copies_tracker*, num_buckets, index_size] | rename replicated_copies_tracker.*.* as rp**, searchable_copies_tracker.*.* as sb** | foreach rp0actual_copies_per_slot [ eval replicated_data_copies_ratio = ('rp0actual_copies_per_slot' / 'rp0expected_total_per_slot') ] | foreach sb0actual_copies_per_slot [ eval searchable_data_copies_ratio = ('sb0actual_copies_per_slot' / 'sb0expected_total_per_slot')] | eval is_searchable = if((is_searchable == 1) or (is_searchable == "1"), "Yes", "No") | eval index_size_gb = round(index_size / 1024 / 1024 / 1024, 2) | fields title, is_searchable, searchable_data_copies_ratio, replicated_data_copies_ratio, num_buckets, index_size_gb | search title="***" | search is_searchable="*" | stats latest(searchable_data_copies_ratio) as searchable_data_copies_ratio, latest(replicated_data_copies_ratio) as replicated_data_copies_ratio, latest(num_buckets) as num_buckets, latest(index_size_gb) as index_size_gb by title | fields title searchable_data_copies_ratio replicated_data_copies_ratio num_buckets index_size_gb`,
+	`SplunkIndexBucketActivity`:           `search=search earliest=-10m latest=now index=_internal source=*splunkd.log sourcetype=splunkd ((component=HotBucketRoller "finished moving hot to warm") OR (component=BucketMerger "merged")) | eval indexname = if(isnull(idx), "(UNKNOWN)", idx) | stats count(eval(component=="HotBucketRoller")) as bucket_rolls, count(eval(component=="BucketMerger")) as bucket_merges by indexname | fields indexname, bucket_merges, bucket_rolls`,
+	`SplunkIngestionErrors`:               `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd log_level=ERROR component=*Processor | eval host = if(isnull(host), "(UNKNOWN)", host) | stats count as errors by host, component | fields host, component, errors`,
+	`SplunkSchedulerDelegatedCount`:       `search=search earliest=-10m latest=now index=_internal sourcetype=scheduler status="delegated_remote_completion" | eval host = if(isnull(host), "(UNKNOWN)", host) | stats count as delegated by host | fields host, delegated`,
+	`SplunkIndexSearchableTest`:           `search=search index=%s | head 1 | stats count as events | fields events`,
+	`SplunkBundlePushSize`:                `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd component=DistributedBundleReplicationManager bundle_file_size=* | rex "peer_name=(?<peer>[^,\s]%2B)" | eval peer = if(isnull(peer), "(UNKNOWN)", peer) | eval size_bytes = round(bundle_file_size * 1024) | stats latest(size_bytes) as size_bytes by peer | fields peer, size_bytes`,
+	`SplunkSchedulerContinuedCount`:       `search=search earliest=-10m latest=now index=_internal sourcetype=scheduler status="continued" | eval savedsearch_name = if(isnull(savedsearch_name), "(UNKNOWN)", savedsearch_name) | stats count as continued by savedsearch_name | fields savedsearch_name, continued`,
+	`SplunkQueueThroughput`:               `search=search earliest=-10m latest=now index=_internal source=*metrics.log sourcetype=splunkd group=pipeline (name=parsing OR name=merging OR name=typing OR name=indexerpipe) | eval queue = case(name=="parsing", "parsingqueue", name=="merging", "aggqueue", name=="typing", "typingqueue", name=="indexerpipe", "indexqueue") | stats max(executes) as executes by _time, host, queue | stats sum(executes) as events by host, queue | eval events_per_second = round(events / 600, 2) | fields host, queue, events_per_second`,
+	`SplunkIndexerSearchesServed`:         `search=search earliest=-10m latest=now index=_audit sourcetype=audittrail action=search info=granted search_id="*remote_*" | eval host = if(isnull(host), "(UNKNOWN)", host) | stats count as searches_served by host | fields host, searches_served`,
+	`SplunkIndexerThrottledSeconds`:       `search=search earliest=-10m latest=now index=_internal source=*metrics.log sourcetype=splunkd group=queue name=indexqueue blocked=true | eval host = if(isnull(host), "(UNKNOWN)", host) | stats count as samples by host | eval throttled_seconds = samples * 30 | fields host, throttled_seconds`,
+	`SplunkIndexFrozenArchiveFailures`:    `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd component=*Frozen* log_level=ERROR | rex "idx=(?<indexname>[^ ,]%2B)" | eval indexname = if(isnull(indexname), "(UNKNOWN)", indexname) | stats count as archive_failures by indexname | fields indexname, archive_failures`,
+	`SplunkIndexerEventsDroppedNoIndex`:   `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd component=IndexProcessor "unconfigured/disabled/deleted index" | rex "index=(?<indexname>[^ ]%2B) with" | eval indexname = if(isnull(indexname), "(UNKNOWN)", indexname) | stats count as events_dropped by indexname | fields indexname, events_dropped`,
+	`SplunkIndexBucketsQuarantined`:       `search=| dbinspect index=* corruptonly=true | stats dc(bucketId) as quarantined by index | rename index as indexname | fields indexname, quarantined`,
+	`SplunkIngestionTruncations`:          `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd component=LineBreakingProcessor "Truncating" | rex "data_sourcetype=\"(?<data_sourcetype>[^\"]%2B)\"" | eval sourcetype = if(isnull(data_sourcetype), "(UNKNOWN)", data_sourcetype) | stats count as truncations by sourcetype | fields sourcetype, truncations`,
+	`SplunkKVStoreLookups`:                `search=search earliest=-10m latest=now index=_introspection sourcetype=kvstore component=KVStoreProfilingStats data.op=query | rex field=data.ns "^(?<app>[^.]%2B)\.(?<collection>.%2B)$" | search collection=* | stats count as active, count(eval('data.millis' > 100)) as slow by app, collection | fields app, collection, active, slow`,
+	`SplunkLongRunningSearches`:           `search=search earliest=-10m latest=now index=_audit sourcetype=audittrail action=search info=completed total_run_time>{{.LongRunningSearchSeconds}} | eval user = if(isnull(user), "(UNKNOWN)", user) | stats count as long_running by user | fields user, long_running`,
+	`SplunkSourcetypeEvents`:              `search=| metadata type=sourcetypes index=* earliest=-10m latest=now | eval sourcetype = if(isnull(sourcetype), "(UNKNOWN)", sourcetype) | rename totalCount as events | fields sourcetype, splunk_server, events`,
+	`SplunkClusterPeerChurn`:              `search=search earliest=-10m latest=now index=_internal sourcetype=splunkd component=CMMaster (event=addPeer OR (transitioning to=Down)) | eval peer = if(isnull(peer_name), "(UNKNOWN)", peer_name) | stats count(eval(event=="addPeer")) as joins, count(eval(to=="Down")) as leaves by peer | fields peer, joins, leaves`,
+	`SplunkClusterBucketSyncLag`:          `search=search earliest=-24h latest=now index=_internal sourcetype=splunkd component=BucketReplicator "replicated bucket" | stats max(_time) as last_sync by host | eval peer = if(isnull(host), "(UNKNOWN)", host) | eval lag_seconds = round(now() - last_sync) | fields peer, lag_seconds`,
+	`SplunkIndexBucketSizes`:              `search=| dbinspect index=* | eval indexname = if(isnull(index), "(UNKNOWN)", index) | stats avg(sizeOnDiskMB) as avg_size_mb, max(sizeOnDiskMB) as max_size_mb by indexname | eval avg_size_bytes = round(avg_size_mb * 1024 * 1024), max_size_bytes = round(max_size_mb * 1024 * 1024) | fields indexname, avg_size_bytes, max_size_bytes`,
+	`SplunkConcurrentSearches`:            `search=| rest splunk_server=local /services/server/status/resource-usage/splunk-processes | eval sid = if('search_props.role' == "head", 'search_props.sid', null()) | eval host = if(isnull(splunk_server), "(UNKNOWN)", splunk_server) | stats dc(sid) as concurrent_searches by host | fields host, concurrent_searches`,
+}
+
+// searches templated with search_variables, along with whether the metrics they feed are enabled
+var templatedSearches = map[string]func(metadata.MetricsConfig) bool{
+	`SplunkIoAvgIops`: func(m metadata.MetricsConfig) bool { return m.SplunkIoAvgIops.Enabled },
+}
+
+// Substitutes vars into the {{.Name}} placeholders of search. Values are query escaped since searches are
+// sent as form encoded bodies. A placeholder without a value is an error.
+func renderSearch(search string, vars map[string]string) (string, error) {
+	if !strings.Contains(search, "{{") {
+		return search, nil
+	}
+
+	t, err := template.New("search").Option("missingkey=error").Parse(search)
+	if err != nil {
+		return "", err
+	}
+
+	escaped := make(map[string]string, len(vars))
+	for k, v := range vars {
+		escaped[k] = url.QueryEscape(v)
+	}
+
+	var b strings.Builder
+	if err = t.Execute(&b, escaped); err != nil {
+		return "", err
+	}
+	return b.String(), nil
 }
 
 var apiDict = map[string]string{
-	`SplunkIndexerThroughput`:   `/services/server/introspection/indexer?output_mode=json`,
-	`SplunkDataIndexesExtended`: `/services/data/indexes-extended?output_mode=json&count=-1`,
-	`SplunkIntrospectionQueues`: `/services/server/introspection/queues?output_mode=json&count=-1`,
+	`SplunkIndexerThroughput`:       `/services/server/introspection/indexer?output_mode=json`,
+	`SplunkDataIndexesExtended`:     `/services/data/indexes-extended?output_mode=json&count=-1`,
+	`SplunkDataIndexes`:             `/services/data/indexes?output_mode=json&count=-1`,
+	`SplunkIntrospectionQueues`:     `/services/server/introspection/queues?output_mode=json&count=-1`,
+	`SplunkLookupTableFiles`:        `/services/data/lookup-table-files?output_mode=json&count=100&offset=%d`,
+	`SplunkKVStoreCollections`:      `/services/storage/collections/config?output_mode=json&count=100&offset=%d`,
+	`SplunkKVStoreStats`:            `/services/server/introspection/kvstore/collectionstats?output_mode=json`,
+	`SplunkServerInfo`:              `/services/server/info?output_mode=json`,
+	`SplunkSearchJobProperties`:     `/services/search/jobs/%s?output_mode=json`,
+	`SplunkClusterMasterInfo`:       `/services/cluster/master/info?output_mode=json`,
+	`SplunkClusterMasterFixup`:      `/services/cluster/master/fixup?output_mode=json&count=-1&level=%s`,
+	`SplunkDistributedPeers`:        `/services/search/distributed/peers?output_mode=json&count=-1`,
+	`SplunkSummarization`:           `/services/admin/summarization?by_tstats=t&output_mode=json&count=-1`,
+	`SplunkClusterMasterGeneration`: `/services/cluster/master/generation/master?output_mode=json`,
+	`SplunkClusterMasterPeers`:      `/services/cluster/master/peers?output_mode=json&count=-1`,
+	`SplunkClusterMasterBuckets`:    `/services/cluster/master/buckets?output_mode=json&count=1000&offset=%d`,
+	`SplunkClusterMasterIndexes`:    `/services/cluster/master/indexes?output_mode=json&count=-1`,
+	`SplunkKVStoreStatus`:           `/services/kvstore/status?output_mode=json`,
+	`SplunkKVStoreCollectionStats`:  `/services/server/introspection/kvstore/collectionstats?output_mode=json`,
+	`SplunkACSIndexes`:              `/adminconfig/v2/indexes?count=%d&offset=%d`,
+	`SplunkHealthDetails`:           `/services/server/health/splunkd/details?output_mode=json`,
+	`SplunkSHClusterStatus`:         `/services/shcluster/status?output_mode=json`,
+	`SplunkSavedSearches`:           `/services/saved/searches?output_mode=json&count=-1`,
+	`SplunkFiredAlerts`:             `/services/alerts/fired_alerts?output_mode=json&count=-1`,
+	`SplunkDispatchArtifacts`:       `/services/server/status/dispatch-artifacts?output_mode=json`,
 }
 
 type searchResponse struct {
+	// name of the search, which its per-search settings are keyed by
+	name   string
 	search string
 	Jobid  *string `xml:"sid"`
 	Return int
-	Fields []*field `xml:"result>field"`
+	// rows of results read so far, from every chunk when they are read in chunks
+	Results []searchRow `xml:"-"`
+	// offset of the next chunk of results to read, when they are read in chunks
+	offset int
+}
+
+// A row of search results, mapping the name of each of its fields to its value
+type searchRow map[string]string
+
+// The rows of search results as returned by the search endpoint
+type searchRows struct {
+	Results []struct {
+		Fields []field `xml:"field"`
+	} `xml:"result"`
 }
 
 type field struct {
@@ -37,6 +134,63 @@ type field struct {
 	Value     string `xml:"value>text"`
 }
 
+// '/services/search/jobs/{search_id}'
+type searchJobProperties struct {
+	Entries []searchJobEntry `json:"entry"`
+}
+
+type searchJobEntry struct {
+	Content searchJobContent `json:"content"`
+}
+
+type searchJobContent struct {
+	ScanCount   int64 `json:"scanCount"`
+	EventCount  int64 `json:"eventCount"`
+	ResultCount int64 `json:"resultCount"`
+}
+
+// paging details included with every collection returned by the REST API
+type apiPaging struct {
+	Total   int `json:"total"`
+	PerPage int `json:"perPage"`
+	Offset  int `json:"offset"`
+}
+
+// '/services/data/lookup-table-files', '/services/storage/collections/config'
+type lookups struct {
+	Entries []lookupEntry `json:"entry"`
+	Paging  apiPaging     `json:"paging"`
+}
+
+type lookupEntry struct {
+	Name string    `json:"name"`
+	ACL  lookupACL `json:"acl"`
+}
+
+type lookupACL struct {
+	App string `json:"app"`
+}
+
+// '/services/server/introspection/kvstore/collectionstats'
+type kvStoreStats struct {
+	Entries []kvStoreStatsEntry `json:"entry"`
+}
+
+type kvStoreStatsEntry struct {
+	Content kvStoreStatsContent `json:"content"`
+}
+
+// each element of data is a JSON document describing a single collection
+type kvStoreStatsContent struct {
+	Data []string `json:"data"`
+}
+
+type kvStoreCollectionStats struct {
+	// namespace of the collection in the form app.collection
+	NS   string `json:"ns"`
+	Size int64  `json:"size"`
+}
+
 // '/services/server/introspection/indexer'
 type indexThroughput struct {
 	Entries []idxTEntry `json:"entry"`
@@ -66,6 +220,9 @@ type IdxEContent struct {
 	TotalEventCount  int            `json:"totalEventCount"`
 	TotalSize        string         `json:"total_size"`
 	TotalRawSize     string         `json:"total_raw_size"`
+	MaxTotalDataSize int64          `json:"maxTotalDataSizeMB"`
+	MaxWarmDBCount   int64          `json:"maxWarmDBCount"`
+	MaxTime          string         `json:"maxTime"`
 	BucketDirs       IdxEBucketDirs `json:"bucket_dirs"`
 }
 
@@ -81,8 +238,34 @@ type IdxEBucketDirsDetails struct {
 	EventMaxTime    string `json:"event_max_time"`
 	EventMinTime    string `json:"event_min_time"`
 	HotBucketCount  string `json:"hot_bucket_count"`
+	HotBucketSize   string `json:"hot_bucket_size"`
 	WarmBucketCount string `json:"warm_bucket_count"`
 	WarmBucketSize  string `json:"warm_bucket_size"`
+	// the size of the buckets of the cold and thawed paths, which hold a single bucket state
+	BucketSize string `json:"bucket_size"`
+}
+
+// '/services/data/indexes'
+type Indexes struct {
+	Entries []IndexesEntry `json:"entry"`
+}
+
+type IndexesEntry struct {
+	Name    string         `json:"name"`
+	ACL     IndexesACL     `json:"acl"`
+	Content IndexesContent `json:"content"`
+}
+
+type IndexesACL struct {
+	// one of user, app, global or system
+	Sharing string `json:"sharing"`
+}
+
+type IndexesContent struct {
+	ColdToFrozenDir    string `json:"coldToFrozenDir"`
+	ColdToFrozenScript string `json:"coldToFrozenScript"`
+	// a number of buckets, or auto or auto_high_volume
+	MaxHotBuckets string `json:"maxHotBuckets"`
 }
 
 // '/services/server/introspection/queues'
@@ -101,3 +284,330 @@ type IdxQContent struct {
 	LargestSize      int `json:"largest_size"`
 	MaxSizeBytes     int `json:"max_size_bytes"`
 }
+
+// '/services/cluster/master/info'
+type ClusterMasterInfo struct {
+	Entries []ClusterMasterInfoEntry `json:"entry"`
+}
+
+type ClusterMasterInfoEntry struct {
+	Content ClusterMasterInfoContent `json:"content"`
+}
+
+type ClusterMasterInfoContent struct {
+	IndexingReady   bool `json:"indexing_ready_flag"`
+	MaintenanceMode bool `json:"maintenance_mode"`
+}
+
+// '/services/cluster/master/fixup'
+type ClusterMasterFixup struct {
+	Entries []ClusterMasterFixupEntry `json:"entry"`
+}
+
+type ClusterMasterFixupEntry struct {
+	Name    string                    `json:"name"`
+	Content ClusterMasterFixupContent `json:"content"`
+}
+
+type ClusterMasterFixupContent struct {
+	Index   string                   `json:"index"`
+	Initial ClusterMasterFixupReason `json:"initial"`
+	Latest  ClusterMasterFixupReason `json:"latest"`
+}
+
+type ClusterMasterFixupReason struct {
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// '/services/cluster/master/generation'
+type ClusterMasterGeneration struct {
+	Entries []ClusterMasterGenerationEntry `json:"entry"`
+}
+
+type ClusterMasterGenerationEntry struct {
+	Content ClusterMasterGenerationContent `json:"content"`
+}
+
+type ClusterMasterGenerationContent struct {
+	GenerationID splunkInt `json:"generation_id"`
+}
+
+// '/services/cluster/master/peers'
+type ClusterMasterPeers struct {
+	Entries []ClusterMasterPeerEntry `json:"entry"`
+}
+
+type ClusterMasterPeerEntry struct {
+	Name    string                   `json:"name"`
+	Content ClusterMasterPeerContent `json:"content"`
+}
+
+type ClusterMasterPeerContent struct {
+	Label            string    `json:"label"`
+	BaseGenerationID splunkInt `json:"base_generation_id"`
+}
+
+// '/services/cluster/master/buckets'
+type ClusterMasterBuckets struct {
+	Entries []ClusterMasterBucketEntry `json:"entry"`
+	Paging  apiPaging                  `json:"paging"`
+}
+
+type ClusterMasterBucketEntry struct {
+	Name    string                     `json:"name"`
+	Content ClusterMasterBucketContent `json:"content"`
+}
+
+type ClusterMasterBucketContent struct {
+	// the copies of the bucket keyed by the guid of the peer holding them
+	Peers map[string]ClusterMasterBucketCopy `json:"peers"`
+}
+
+type ClusterMasterBucketCopy struct {
+	Status string `json:"status"`
+}
+
+// '/services/cluster/master/indexes'
+type ClusterMasterIndexes struct {
+	Entries []ClusterMasterIndexEntry `json:"entry"`
+}
+
+type ClusterMasterIndexEntry struct {
+	Name    string                    `json:"name"`
+	Content ClusterMasterIndexContent `json:"content"`
+}
+
+type ClusterMasterIndexContent struct {
+	// whether every bucket of the index had its replication factor of copies as it was being written,
+	// as opposed to only once the cluster has caught up with replication
+	RFMetAtIngest splunkBool `json:"rf_met_at_ingest"`
+}
+
+// '/services/kvstore/status'
+type KVStoreStatus struct {
+	Entries []KVStoreStatusEntry `json:"entry"`
+}
+
+type KVStoreStatusEntry struct {
+	Content KVStoreStatusContent `json:"content"`
+}
+
+type KVStoreStatusContent struct {
+	Current KVStoreStatusCurrent `json:"current"`
+	// the members of the replica set of a search head cluster, keyed by their position in it. A standalone
+	// KV store has none.
+	Members map[string]KVStoreMember `json:"members"`
+}
+
+type KVStoreStatusCurrent struct {
+	Status            string `json:"status"`
+	HostAndPort       string `json:"hostAndPort"`
+	ReplicationStatus string `json:"replicationStatus"`
+	// oldest and newest operations held in the oplog, in seconds since the epoch
+	OplogStartTimestamp splunkInt `json:"oplogStartTimestamp"`
+	OplogEndTimestamp   splunkInt `json:"oplogEndTimestamp"`
+	StorageSize         splunkInt `json:"storageSize"`
+}
+
+type KVStoreMember struct {
+	HostAndPort       string `json:"hostAndPort"`
+	ReplicationStatus string `json:"replicationStatus"`
+}
+
+// '/services/server/introspection/kvstore/collectionstats'
+type KVStoreCollectionStats struct {
+	Entries []KVStoreCollectionStatsEntry `json:"entry"`
+}
+
+type KVStoreCollectionStatsEntry struct {
+	Content KVStoreCollectionStatsContent `json:"content"`
+}
+
+type KVStoreCollectionStatsContent struct {
+	// the statistics of each collection, each a JSON document of its own
+	Data []string `json:"data"`
+}
+
+// A document of the statistics of a KV store collection
+type KVStoreCollection struct {
+	// the namespace of the collection, {app}.{collection}
+	NS   string    `json:"ns"`
+	Size splunkInt `json:"size"`
+}
+
+// '/adminconfig/v2/indexes' of the Admin Config Service
+type ACSIndex struct {
+	Name            string    `json:"name"`
+	TotalEventCount splunkInt `json:"totalEventCount"`
+	TotalRawSizeMB  splunkInt `json:"totalRawSizeMB"`
+}
+
+// '/services/server/health/splunkd/details'
+type HealthDetails struct {
+	Entries []HealthDetailsEntry `json:"entry"`
+}
+
+type HealthDetailsEntry struct {
+	Content HealthFeature `json:"content"`
+}
+
+// A node of the splunkd health report, whose health is the worst of its own and of its features
+type HealthFeature struct {
+	Health   string                   `json:"health"`
+	Features map[string]HealthFeature `json:"features"`
+}
+
+// Returns the feature named name anywhere below f. Feature names are unique within the health report.
+func (f HealthFeature) find(name string) (HealthFeature, bool) {
+	for n, sub := range f.Features {
+		if strings.EqualFold(n, name) {
+			return sub, true
+		}
+		if found, ok := sub.find(name); ok {
+			return found, true
+		}
+	}
+	return HealthFeature{}, false
+}
+
+// '/services/server/info'
+type ServerInfo struct {
+	Entries []ServerInfoEntry `json:"entry"`
+}
+
+type ServerInfoEntry struct {
+	Content ServerInfoContent `json:"content"`
+}
+
+type ServerInfoContent struct {
+	ServerName string `json:"serverName"`
+	// when splunkd started, in seconds since the epoch
+	StartupTime splunkInt `json:"startup_time"`
+	// the roles the instance holds in the deployment, such as indexer or cluster_master
+	ServerRoles []string `json:"server_roles"`
+}
+
+// '/services/shcluster/status'
+type SHClusterStatus struct {
+	Entries []SHClusterStatusEntry `json:"entry"`
+}
+
+type SHClusterStatusEntry struct {
+	Content SHClusterStatusContent `json:"content"`
+}
+
+type SHClusterStatusContent struct {
+	Captain SHClusterCaptain `json:"captain"`
+}
+
+type SHClusterCaptain struct {
+	// the server name of the member elected captain
+	Label string `json:"label"`
+}
+
+// '/services/admin/summarization'
+type Summarization struct {
+	Entries []SummarizationEntry `json:"entry"`
+}
+
+type SummarizationEntry struct {
+	Name    string               `json:"name"`
+	Content SummarizationContent `json:"content"`
+}
+
+type SummarizationContent struct {
+	InProgress splunkBool `json:"summary.is_inprogress"`
+}
+
+// Splunk reports flags as JSON booleans, numbers or strings depending on the endpoint and version
+type splunkBool bool
+
+func (b *splunkBool) UnmarshalJSON(data []byte) error {
+	// empty strings and null are reported for flags which are not set, and are read as false
+	v, _ := strconv.ParseBool(strings.Trim(string(data), `"`))
+	*b = splunkBool(v)
+	return nil
+}
+
+// Splunk reports some numbers as JSON numbers or strings depending on the endpoint and version
+type splunkInt int64
+
+func (i *splunkInt) UnmarshalJSON(data []byte) error {
+	v := strings.Trim(string(data), `"`)
+	if v == "" || v == "null" {
+		*i = 0
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = splunkInt(n)
+	return nil
+}
+
+// '/services/saved/searches'
+type SavedSearches struct {
+	Entries []SavedSearchEntry `json:"entry"`
+}
+
+type SavedSearchEntry struct {
+	Name    string             `json:"name"`
+	Content SavedSearchContent `json:"content"`
+}
+
+type SavedSearchContent struct {
+	Disabled     splunkBool `json:"disabled"`
+	IsScheduled  splunkBool `json:"is_scheduled"`
+	Actions      string     `json:"actions"`
+	AlertTrack   splunkBool `json:"alert.track"`
+	DigestMode   splunkBool `json:"alert.digest_mode"`
+	EarliestTime string     `json:"dispatch.earliest_time"`
+}
+
+// '/services/alerts/fired_alerts'
+type FiredAlerts struct {
+	Entries []FiredAlertEntry `json:"entry"`
+}
+
+type FiredAlertEntry struct {
+	// the name of the saved search which triggered the alerts, "-" for the summary of all of them
+	Name    string            `json:"name"`
+	Content FiredAlertContent `json:"content"`
+}
+
+type FiredAlertContent struct {
+	TriggeredAlertCount splunkInt `json:"triggered_alert_count"`
+}
+
+// '/services/search/distributed/peers'
+type DistributedPeers struct {
+	Entries []DistributedPeerEntry `json:"entry"`
+}
+
+type DistributedPeerEntry struct {
+	Name    string                 `json:"name"`
+	Content DistributedPeerContent `json:"content"`
+}
+
+type DistributedPeerContent struct {
+	PeerName     string   `json:"peerName"`
+	Status       string   `json:"status"`
+	HealthStatus string   `json:"health_status"`
+	ServerRoles  []string `json:"server_roles"`
+}
+
+// '/services/server/status/dispatch-artifacts'
+type DispatchArtifacts struct {
+	Entries []DispatchArtifactsEntry `json:"entry"`
+}
+
+type DispatchArtifactsEntry struct {
+	Content DispatchArtifactsContent `json:"content"`
+}
+
+type DispatchArtifactsContent struct {
+	// artifacts whose time to live expired, waiting on the dispatch reaper to delete them
+	PendingDeletionCount splunkInt `json:"pending_deletion_count"`
+}